@@ -0,0 +1,398 @@
+package data
+
+import (
+	"math"
+	"sort"
+
+	"github.com/soupstoregames/gamelib/utils"
+)
+
+// Graph is the view of a graph used by the analysis functions.
+// UndirectedGraph, WeightedGraph and DirectedGraph all implement it.
+type Graph interface {
+	// Len returns the number of node ids in use, including removed nodes whose ids are free.
+	Len() int
+	// Contains reports whether id is a node that has not been removed.
+	Contains(id int) bool
+	// Neighbors appends the nodes one edge away from id, once for each edge.
+	Neighbors(results *[]int, id int)
+}
+
+// Components appends the connected component of each node id to labels, or -1 for removed
+// nodes, and returns the number of components. Components are numbered from 0 in order of their
+// lowest node id. Edges of a DirectedGraph are followed both ways, giving weak components.
+func Components(labels *[]int, g Graph) int {
+	n := g.Len()
+	set := newDisjointSet(n)
+	var adj []int
+	for i := 0; i < n; i++ {
+		if !g.Contains(i) {
+			continue
+		}
+		adj = adj[:0]
+		g.Neighbors(&adj, i)
+		for _, j := range adj {
+			set.union(i, j)
+		}
+	}
+
+	label := make([]int, n)
+	for i := range label {
+		label[i] = -1
+	}
+	count := 0
+	for i := 0; i < n; i++ {
+		if !g.Contains(i) {
+			*labels = append(*labels, -1)
+			continue
+		}
+		root := set.find(i)
+		if label[root] == -1 {
+			label[root] = count
+			count++
+		}
+		*labels = append(*labels, label[root])
+	}
+	return count
+}
+
+// ArticulationPoints appends, in ascending order, the nodes whose removal would split their
+// component. g must be undirected.
+func ArticulationPoints(results *[]int, g Graph) {
+	start := len(*results)
+	lowlinks(g, func(node int) {
+		*results = append(*results, node)
+	}, nil)
+	sort.Ints((*results)[start:])
+}
+
+// Bridges appends the edges whose removal would split their component, as node pairs with the
+// lower id first, in ascending order. Nodes joined by more than one edge are never bridged.
+// g must be undirected.
+func Bridges(results *[][2]int, g Graph) {
+	start := len(*results)
+	lowlinks(g, nil, func(a, b int) {
+		if a > b {
+			a, b = b, a
+		}
+		*results = append(*results, [2]int{a, b})
+	})
+	bridges := (*results)[start:]
+	sort.Slice(bridges, func(i, j int) bool {
+		if bridges[i][0] != bridges[j][0] {
+			return bridges[i][0] < bridges[j][0]
+		}
+		return bridges[i][1] < bridges[j][1]
+	})
+}
+
+// lowlinks runs Tarjan's depth first search, where the lowlink of a node is the earliest
+// discovered node its subtree can reach without going back along the edge it came from.
+func lowlinks(g Graph, articulation func(node int), bridge func(a, b int)) {
+	n := g.Len()
+	order := make([]int, n)
+	low := make([]int, n)
+	time := 0
+
+	var visit func(node, parent int)
+	visit = func(node, parent int) {
+		time++
+		order[node], low[node] = time, time
+
+		var adj []int
+		g.Neighbors(&adj, node)
+		children := 0
+		skippedParent := false
+		isArticulation := false
+		for _, next := range adj {
+			// only skip one edge back to the parent so parallel edges count as a cycle
+			if next == parent && !skippedParent {
+				skippedParent = true
+				continue
+			}
+			if order[next] != 0 {
+				if order[next] < low[node] {
+					low[node] = order[next]
+				}
+				continue
+			}
+
+			children++
+			visit(next, node)
+			if low[next] < low[node] {
+				low[node] = low[next]
+			}
+			if parent != -1 && low[next] >= order[node] {
+				isArticulation = true
+			}
+			if low[next] > order[node] && bridge != nil {
+				bridge(node, next)
+			}
+		}
+		// the root of the search only splits the graph if it has separate subtrees
+		if parent == -1 && children > 1 {
+			isArticulation = true
+		}
+		if isArticulation && articulation != nil {
+			articulation(node)
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		if g.Contains(i) && order[i] == 0 {
+			visit(i, -1)
+		}
+	}
+}
+
+// FindCycle appends the nodes of a cycle following edges in their direction, and returns false if
+// there is none. Use it with a DirectedGraph; in an undirected graph every edge leads straight
+// back, so use FindUndirectedCycle instead.
+func FindCycle(results *[]int, g Graph) bool {
+	return findCycle(results, g, false)
+}
+
+// FindUndirectedCycle appends the nodes of a cycle in an undirected graph, and returns false if
+// the graph is a forest. Loops and parallel edges count as cycles.
+func FindUndirectedCycle(results *[]int, g Graph) bool {
+	return findCycle(results, g, true)
+}
+
+func findCycle(results *[]int, g Graph, undirected bool) bool {
+	const (
+		unvisited = iota
+		onPath
+		finished
+	)
+	n := g.Len()
+	state := make([]uint8, n)
+	var path []int
+
+	var visit func(node, parent int) bool
+	visit = func(node, parent int) bool {
+		state[node] = onPath
+		path = append(path, node)
+
+		var adj []int
+		g.Neighbors(&adj, node)
+		skippedParent := false
+		for _, next := range adj {
+			if undirected && next == parent && !skippedParent {
+				skippedParent = true
+				continue
+			}
+			switch state[next] {
+			case onPath:
+				// the cycle runs along the path from next back round to node
+				i, _ := utils.Find(path, next)
+				*results = append(*results, path[i:]...)
+				return true
+			case unvisited:
+				if visit(next, node) {
+					return true
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[node] = finished
+		return false
+	}
+
+	for i := 0; i < n; i++ {
+		if g.Contains(i) && state[i] == unvisited && visit(i, -1) {
+			return true
+		}
+	}
+	return false
+}
+
+// TopologicalSort appends every node so that each edge leads from an earlier node to a later one,
+// for example to order a tech tree so every technology comes after its prerequisites.
+// It returns false, leaving results unchanged, if the graph has a cycle.
+func TopologicalSort(results *[]int, g Graph) bool {
+	n := g.Len()
+	incoming := make([]int, n)
+	var adj []int
+	nodes := 0
+	for i := 0; i < n; i++ {
+		if !g.Contains(i) {
+			continue
+		}
+		nodes++
+		adj = adj[:0]
+		g.Neighbors(&adj, i)
+		for _, j := range adj {
+			incoming[j]++
+		}
+	}
+
+	// Kahn's algorithm: repeatedly take a node with nothing left pointing at it
+	start := len(*results)
+	for i := 0; i < n; i++ {
+		if g.Contains(i) && incoming[i] == 0 {
+			*results = append(*results, i)
+		}
+	}
+	for head := start; head < len(*results); head++ {
+		adj = adj[:0]
+		g.Neighbors(&adj, (*results)[head])
+		for _, j := range adj {
+			incoming[j]--
+			if incoming[j] == 0 {
+				*results = append(*results, j)
+			}
+		}
+	}
+
+	if len(*results)-start < nodes {
+		*results = (*results)[:start]
+		return false
+	}
+	return true
+}
+
+// MinimumSpanningTree appends the ids of the edges that connect every node at the least total
+// weight, using Kruskal's algorithm, and returns that weight. A disconnected graph gives a tree
+// for each component.
+func MinimumSpanningTree[N, E any](results *[]int, g *WeightedGraph[N, E], weight func(e Edge[E]) float64) float64 {
+	type weightedEdge struct {
+		id     int
+		weight float64
+	}
+	edges := make([]weightedEdge, 0, g.EdgeLen())
+	for id := 0; id < g.EdgeLen(); id++ {
+		if g.ContainsEdge(id) {
+			edges = append(edges, weightedEdge{id: id, weight: weight(g.Edge(id))})
+		}
+	}
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].weight < edges[j].weight
+	})
+
+	set := newDisjointSet(g.Len())
+	var total float64
+	for _, e := range edges {
+		edge := g.Edge(e.id)
+		if set.union(edge.From, edge.To) {
+			*results = append(*results, e.id)
+			total += e.weight
+		}
+	}
+	return total
+}
+
+// MinimumSpanningTreePrim appends the node pairs that connect every node at the least total cost,
+// using Prim's algorithm, and returns that cost. It suits graphs without edge ids, such as
+// UndirectedGraph. A nil cost counts every edge as 1. g must be undirected.
+func MinimumSpanningTreePrim(results *[][2]int, g Graph, cost CostFunc) float64 {
+	n := g.Len()
+	s := NewGraphSearch()
+	s.reset(n)
+
+	// grow a tree from each component in turn
+	for root := 0; root < n; root++ {
+		if g.Contains(root) && s.closed[root] != s.generation {
+			s.start(root, 0)
+			s.search(g, -1, cost, nil, true)
+		}
+	}
+
+	var total float64
+	for node := 0; node < n; node++ {
+		if parent := s.parent[node]; s.closed[node] == s.generation && parent != -1 {
+			*results = append(*results, [2]int{parent, node})
+			total += s.cost[node]
+		}
+	}
+	return total
+}
+
+// PathTree holds the cheapest paths from a root node to every other node.
+type PathTree struct {
+	Root int
+	// Parent[i] is the node before i on the cheapest path from the root, or -1 for the root and
+	// nodes that cannot be reached.
+	Parent []int
+	// Cost[i] is the cost of the cheapest path from the root to i, or +Inf if i cannot be reached.
+	Cost []float64
+}
+
+// ShortestPathTree finds the cheapest path from root to every node with Dijkstra's algorithm,
+// for example to build a flow field towards a goal. A nil cost counts every edge as 1.
+func ShortestPathTree(g Graph, root int, cost CostFunc) PathTree {
+	n := g.Len()
+	s := NewGraphSearch()
+	s.reset(n)
+	s.start(root, 0)
+	s.search(g, -1, cost, nil, false)
+
+	tree := PathTree{Root: root, Parent: s.parent[:n], Cost: s.cost[:n]}
+	for i := 0; i < n; i++ {
+		if s.seen[i] != s.generation {
+			tree.Parent[i] = -1
+			tree.Cost[i] = math.Inf(1)
+		}
+	}
+	return tree
+}
+
+// Reachable reports whether there is a path from the root to node.
+func (t PathTree) Reachable(node int) bool {
+	return !math.IsInf(t.Cost[node], 1)
+}
+
+// Path appends the nodes from the root to node and returns false, leaving path unchanged, if node
+// cannot be reached.
+func (t PathTree) Path(path *[]int, node int) bool {
+	if !t.Reachable(node) {
+		return false
+	}
+	first := len(*path)
+	for ; node != -1; node = t.Parent[node] {
+		*path = append(*path, node)
+	}
+	p := *path
+	for i, j := first, len(p)-1; i < j; i, j = i+1, j-1 {
+		p[i], p[j] = p[j], p[i]
+	}
+	return true
+}
+
+// disjointSet tracks which nodes have been joined together, for Kruskal and components.
+type disjointSet struct {
+	parent []int
+	size   []int
+}
+
+func newDisjointSet(n int) disjointSet {
+	d := disjointSet{parent: make([]int, n), size: make([]int, n)}
+	for i := range d.parent {
+		d.parent[i] = i
+		d.size[i] = 1
+	}
+	return d
+}
+
+func (d disjointSet) find(i int) int {
+	for d.parent[i] != i {
+		// path halving keeps the trees shallow
+		d.parent[i] = d.parent[d.parent[i]]
+		i = d.parent[i]
+	}
+	return i
+}
+
+// union joins the sets of a and b, returning false if they were already joined.
+func (d disjointSet) union(a, b int) bool {
+	a, b = d.find(a), d.find(b)
+	if a == b {
+		return false
+	}
+	if d.size[a] < d.size[b] {
+		a, b = b, a
+	}
+	d.parent[b] = a
+	d.size[a] += d.size[b]
+	return true
+}
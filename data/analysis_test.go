@@ -0,0 +1,217 @@
+package data
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func undirectedGraph(n int, edges ...[2]int) *UndirectedGraph[int] {
+	graph := NewUndirectedGraph[int]()
+	for i := 0; i < n; i++ {
+		graph.Insert(i)
+	}
+	for _, e := range edges {
+		graph.Connect(e[0], e[1])
+	}
+	return graph
+}
+
+func directedGraph(n int, edges ...[2]int) *DirectedGraph[int, struct{}] {
+	graph := NewDirectedGraph[int, struct{}]()
+	for i := 0; i < n; i++ {
+		graph.Insert(i)
+	}
+	for _, e := range edges {
+		graph.Connect(e[0], e[1], struct{}{})
+	}
+	return graph
+}
+
+func TestComponents(t *testing.T) {
+	graph := undirectedGraph(7, [2]int{0, 1}, [2]int{1, 2}, [2]int{3, 4}, [2]int{6, 6})
+	graph.Remove(5)
+
+	var labels []int
+	assert.Equal(t, 3, Components(&labels, graph))
+	assert.Equal(t, []int{0, 0, 0, 1, 1, -1, 2}, labels)
+
+	// directed edges join weak components
+	labels = labels[:0]
+	assert.Equal(t, 2, Components(&labels, directedGraph(4, [2]int{1, 0}, [2]int{2, 0})))
+	assert.Equal(t, []int{0, 0, 0, 1}, labels)
+}
+
+func TestArticulationPointsAndBridges(t *testing.T) {
+	// two triangles joined by the path 2 - 3 - 4, with a tail 7 - 8
+	graph := undirectedGraph(9,
+		[2]int{0, 1}, [2]int{1, 2}, [2]int{2, 0},
+		[2]int{2, 3}, [2]int{3, 4},
+		[2]int{4, 5}, [2]int{5, 6}, [2]int{6, 4},
+		[2]int{7, 8},
+	)
+
+	var points []int
+	ArticulationPoints(&points, graph)
+	assert.Equal(t, []int{2, 3, 4}, points)
+
+	var bridges [][2]int
+	Bridges(&bridges, graph)
+	assert.Equal(t, [][2]int{{2, 3}, {3, 4}, {7, 8}}, bridges)
+
+	// a second road between 2 and 3 means it is no longer a bridge
+	weighted := NewWeightedGraph[int, float64]()
+	for i := 0; i < 5; i++ {
+		weighted.Insert(i)
+	}
+	weighted.Connect(0, 1, 1)
+	weighted.Connect(1, 2, 1)
+	weighted.Connect(2, 3, 1)
+	weighted.Connect(2, 3, 2)
+	weighted.Connect(3, 4, 1)
+
+	points = points[:0]
+	ArticulationPoints(&points, weighted)
+	assert.Equal(t, []int{1, 2, 3}, points)
+	bridges = bridges[:0]
+	Bridges(&bridges, weighted)
+	assert.Equal(t, [][2]int{{0, 1}, {1, 2}, {3, 4}}, bridges)
+}
+
+func TestFindCycle(t *testing.T) {
+	var cycle []int
+	assert.False(t, FindCycle(&cycle, directedGraph(4, [2]int{0, 1}, [2]int{0, 2}, [2]int{1, 3}, [2]int{2, 3})))
+	assert.Empty(t, cycle)
+
+	assert.True(t, FindCycle(&cycle, directedGraph(5, [2]int{0, 1}, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}, [2]int{3, 4})))
+	assert.Equal(t, []int{1, 2, 3}, cycle)
+
+	cycle = cycle[:0]
+	assert.True(t, FindCycle(&cycle, directedGraph(2, [2]int{1, 1})))
+	assert.Equal(t, []int{1}, cycle)
+
+	// a tree has no undirected cycle, but closing a loop makes one
+	tree := undirectedGraph(5, [2]int{0, 1}, [2]int{1, 2}, [2]int{1, 3}, [2]int{3, 4})
+	cycle = cycle[:0]
+	assert.False(t, FindUndirectedCycle(&cycle, tree))
+	tree.Connect(4, 1)
+	assert.True(t, FindUndirectedCycle(&cycle, tree))
+	assert.ElementsMatch(t, []int{1, 3, 4}, cycle)
+
+	weighted := NewWeightedGraph[int, float64]()
+	weighted.Insert(0)
+	weighted.Insert(1)
+	weighted.Connect(0, 1, 1)
+	cycle = cycle[:0]
+	assert.False(t, FindUndirectedCycle(&cycle, weighted))
+	weighted.Connect(1, 0, 2)
+	assert.True(t, FindUndirectedCycle(&cycle, weighted))
+	assert.ElementsMatch(t, []int{0, 1}, cycle)
+}
+
+func TestTopologicalSort(t *testing.T) {
+	// a tech tree: 0 and 1 are starting techs, 4 needs 2 and 3
+	graph := directedGraph(6, [2]int{0, 2}, [2]int{1, 2}, [2]int{1, 3}, [2]int{2, 4}, [2]int{3, 4}, [2]int{5, 0})
+
+	order := []int{-1}
+	assert.True(t, TopologicalSort(&order, graph))
+	assert.Equal(t, -1, order[0])
+	order = order[1:]
+	assert.Len(t, order, 6)
+	position := map[int]int{}
+	for i, node := range order {
+		position[node] = i
+	}
+	for id := 0; id < graph.EdgeLen(); id++ {
+		e := graph.Edge(id)
+		assert.Less(t, position[e.From], position[e.To])
+	}
+
+	graph.Connect(4, 1, struct{}{})
+	order = order[:0]
+	assert.False(t, TopologicalSort(&order, graph))
+	assert.Empty(t, order)
+
+	// removed nodes are left out
+	graph.Remove(4)
+	assert.True(t, TopologicalSort(&order, graph))
+	assert.Len(t, order, 5)
+}
+
+func TestMinimumSpanningTree(t *testing.T) {
+	graph := NewWeightedGraph[string, float64]()
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		graph.Insert(name)
+	}
+	ab := graph.Connect(0, 1, 4)
+	graph.Connect(0, 2, 4)
+	bc := graph.Connect(1, 2, 2)
+	cd := graph.Connect(2, 3, 3)
+	graph.Connect(2, 4, 4)
+	de := graph.Connect(3, 4, 3)
+	graph.Connect(1, 3, 5)
+	// f is on its own island
+	weight := func(e Edge[float64]) float64 { return e.Data }
+
+	var edges []int
+	assert.Equal(t, 12.0, MinimumSpanningTree(&edges, graph, weight))
+	assert.ElementsMatch(t, []int{ab, bc, cd, de}, edges)
+
+	// Prim finds the same total on the equivalent unweighted graph
+	plain := undirectedGraph(6)
+	for id := 0; id < graph.EdgeLen(); id++ {
+		e := graph.Edge(id)
+		plain.Connect(e.From, e.To)
+	}
+	cost := func(a, b int) float64 {
+		id, _ := graph.FindEdge(a, b)
+		return graph.Edge(id).Data
+	}
+	var pairs [][2]int
+	assert.Equal(t, 12.0, MinimumSpanningTreePrim(&pairs, plain, cost))
+	assert.Len(t, pairs, 4)
+
+	pairs = pairs[:0]
+	assert.Equal(t, 4.0, MinimumSpanningTreePrim(&pairs, plain, nil))
+	var labels []int
+	Components(&labels, plain)
+	for _, p := range pairs {
+		assert.Equal(t, labels[p[0]], labels[p[1]])
+	}
+}
+
+func TestShortestPathTree(t *testing.T) {
+	graph := NewWeightedGraph[int, float64]()
+	for i := 0; i < 6; i++ {
+		graph.Insert(i)
+	}
+	graph.Connect(0, 1, 7)
+	graph.Connect(0, 2, 9)
+	graph.Connect(0, 5, 14)
+	graph.Connect(1, 2, 10)
+	graph.Connect(1, 3, 15)
+	graph.Connect(2, 3, 11)
+	graph.Connect(2, 5, 2)
+	graph.Connect(3, 4, 6)
+	isolated := graph.Insert(6)
+	cost := func(a, b int) float64 {
+		id, _ := graph.FindEdge(a, b)
+		return graph.Edge(id).Data
+	}
+
+	tree := ShortestPathTree(graph, 0, cost)
+	assert.Equal(t, []float64{0, 7, 9, 20, 26, 11, math.Inf(1)}, tree.Cost)
+	assert.Equal(t, []int{-1, 0, 0, 2, 3, 2, -1}, tree.Parent)
+
+	var path []int
+	assert.True(t, tree.Path(&path, 4))
+	assert.Equal(t, []int{0, 2, 3, 4}, path)
+	assert.False(t, tree.Reachable(isolated))
+	assert.False(t, tree.Path(&path, isolated))
+	assert.Equal(t, []int{0, 2, 3, 4}, path)
+
+	// directed edges are only followed forwards
+	tree = ShortestPathTree(directedGraph(3, [2]int{0, 1}, [2]int{2, 1}), 0, nil)
+	assert.Equal(t, []float64{0, 1, math.Inf(1)}, tree.Cost)
+}
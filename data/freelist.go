@@ -3,6 +3,7 @@ package data
 type freeListEntry[T any] struct {
 	element  T
 	nextFree int
+	erased   bool
 }
 
 // FreeList is a structure that holds any kind of object.
@@ -27,6 +28,7 @@ func (f *FreeList[T]) Insert(element T) int {
 		// set the next free entry from this index
 		f.FirstFree = f.data[index].nextFree
 		f.data[index].nextFree = 0
+		f.data[index].erased = false
 		// set the data at current free index
 		f.data[index].element = element
 		return index
@@ -46,6 +48,7 @@ func (f *FreeList[T]) Set(n int, element T) {
 func (f *FreeList[T]) Erase(n int) {
 	// set the old next free index to this node
 	f.data[n].nextFree = f.FirstFree
+	f.data[n].erased = true
 	// set the first free index to this nodes next free Raw
 	f.FirstFree = n
 }
@@ -59,6 +62,11 @@ func (f *FreeList[T]) Get(n int) T {
 	return f.data[n].element
 }
 
+// Valid reports whether n holds an element that has not been erased.
+func (f *FreeList[T]) Valid(n int) bool {
+	return n >= 0 && n < len(f.data) && !f.data[n].erased
+}
+
 func (f *FreeList[T]) Len() int {
 	return len(f.data)
 }
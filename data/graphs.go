@@ -46,6 +46,16 @@ func (u *UndirectedGraph[T]) Get(id int) GraphNode[T] {
 	return u.data.Get(id)
 }
 
+// Contains reports whether id is a node that has not been removed.
+func (u *UndirectedGraph[T]) Contains(id int) bool {
+	return u.data.Valid(id)
+}
+
+// Neighbors appends the nodes connected to id.
+func (u *UndirectedGraph[T]) Neighbors(results *[]int, id int) {
+	*results = append(*results, u.data.Get(id).Adjacent...)
+}
+
 func (u *UndirectedGraph[T]) Clear() {
 	u.data.Clear()
 }
@@ -94,3 +104,287 @@ func (u *UndirectedGraph[T]) Merge(a, b int) {
 func (u *UndirectedGraph[T]) Len() int {
 	return u.data.Len()
 }
+
+// Edge connects two nodes and carries data such as a cost, a door state or a road type.
+// In an undirected graph From and To are just the two ends.
+type Edge[E any] struct {
+	From int
+	To   int
+	Data E
+}
+
+// Other returns the end of the edge that is not node.
+func (e Edge[E]) Other(node int) int {
+	if e.From == node {
+		return e.To
+	}
+	return e.From
+}
+
+type DirectedNode[N any] struct {
+	Element N
+	// Out and In are the ids of the edges leaving and arriving at the node.
+	Out []int
+	In  []int
+}
+
+// DirectedGraph is a graph of nodes with one-way edges that carry data.
+// Node and edge ids stay stable as other nodes and edges are removed.
+type DirectedGraph[N, E any] struct {
+	nodes FreeList[DirectedNode[N]]
+	edges FreeList[Edge[E]]
+}
+
+func NewDirectedGraph[N, E any]() *DirectedGraph[N, E] {
+	return &DirectedGraph[N, E]{
+		nodes: NewFreeList[DirectedNode[N]](),
+		edges: NewFreeList[Edge[E]](),
+	}
+}
+
+func (d *DirectedGraph[N, E]) Insert(element N) int {
+	return d.nodes.Insert(DirectedNode[N]{
+		Element: element,
+	})
+}
+
+func (d *DirectedGraph[N, E]) Set(id int, element N) {
+	node := d.nodes.Get(id)
+	node.Element = element
+	d.nodes.Set(id, node)
+}
+
+// Remove removes the node and every edge leaving or arriving at it.
+func (d *DirectedGraph[N, E]) Remove(id int) {
+	node := d.nodes.Get(id)
+	for len(node.Out) > 0 {
+		d.Disconnect(node.Out[0])
+		node = d.nodes.Get(id)
+	}
+	for len(node.In) > 0 {
+		d.Disconnect(node.In[0])
+		node = d.nodes.Get(id)
+	}
+	d.nodes.Erase(id)
+}
+
+func (d *DirectedGraph[N, E]) Get(id int) DirectedNode[N] {
+	return d.nodes.Get(id)
+}
+
+// Contains reports whether id is a node that has not been removed.
+func (d *DirectedGraph[N, E]) Contains(id int) bool {
+	return d.nodes.Valid(id)
+}
+
+// Connect adds an edge from one node to another and returns its id.
+// Nodes may be connected by more than one edge.
+func (d *DirectedGraph[N, E]) Connect(from, to int, data E) int {
+	id := d.edges.Insert(Edge[E]{From: from, To: to, Data: data})
+
+	fromNode := d.nodes.Get(from)
+	fromNode.Out = append(fromNode.Out, id)
+	d.nodes.Set(from, fromNode)
+
+	toNode := d.nodes.Get(to)
+	toNode.In = append(toNode.In, id)
+	d.nodes.Set(to, toNode)
+
+	return id
+}
+
+// Disconnect removes the edge.
+func (d *DirectedGraph[N, E]) Disconnect(edge int) {
+	e := d.edges.Get(edge)
+
+	fromNode := d.nodes.Get(e.From)
+	if i, found := utils.Find(fromNode.Out, edge); found {
+		fromNode.Out = utils.RemoveAt(fromNode.Out, i)
+		d.nodes.Set(e.From, fromNode)
+	}
+
+	toNode := d.nodes.Get(e.To)
+	if i, found := utils.Find(toNode.In, edge); found {
+		toNode.In = utils.RemoveAt(toNode.In, i)
+		d.nodes.Set(e.To, toNode)
+	}
+
+	d.edges.Erase(edge)
+}
+
+// Neighbors appends the node at the end of each edge leaving id.
+func (d *DirectedGraph[N, E]) Neighbors(results *[]int, id int) {
+	for _, e := range d.nodes.Get(id).Out {
+		*results = append(*results, d.edges.Get(e).To)
+	}
+}
+
+func (d *DirectedGraph[N, E]) Edge(id int) Edge[E] {
+	return d.edges.Get(id)
+}
+
+func (d *DirectedGraph[N, E]) SetEdge(id int, data E) {
+	e := d.edges.Get(id)
+	e.Data = data
+	d.edges.Set(id, e)
+}
+
+// ContainsEdge reports whether id is an edge that has not been removed.
+func (d *DirectedGraph[N, E]) ContainsEdge(id int) bool {
+	return d.edges.Valid(id)
+}
+
+// FindEdge returns the id of an edge from one node to another.
+func (d *DirectedGraph[N, E]) FindEdge(from, to int) (int, bool) {
+	for _, id := range d.nodes.Get(from).Out {
+		if d.edges.Get(id).To == to {
+			return id, true
+		}
+	}
+	return -1, false
+}
+
+func (d *DirectedGraph[N, E]) Clear() {
+	d.nodes.Clear()
+	d.edges.Clear()
+}
+
+// Len returns the number of node ids in use, including removed nodes whose ids are free.
+func (d *DirectedGraph[N, E]) Len() int {
+	return d.nodes.Len()
+}
+
+// EdgeLen returns the number of edge ids in use, including removed edges whose ids are free.
+func (d *DirectedGraph[N, E]) EdgeLen() int {
+	return d.edges.Len()
+}
+
+type WeightedNode[N any] struct {
+	Element N
+	// Edges are the ids of the edges touching the node.
+	Edges []int
+}
+
+// WeightedGraph is a graph of nodes with two-way edges that carry data.
+// Node and edge ids stay stable as other nodes and edges are removed.
+type WeightedGraph[N, E any] struct {
+	nodes FreeList[WeightedNode[N]]
+	edges FreeList[Edge[E]]
+}
+
+func NewWeightedGraph[N, E any]() *WeightedGraph[N, E] {
+	return &WeightedGraph[N, E]{
+		nodes: NewFreeList[WeightedNode[N]](),
+		edges: NewFreeList[Edge[E]](),
+	}
+}
+
+func (w *WeightedGraph[N, E]) Insert(element N) int {
+	return w.nodes.Insert(WeightedNode[N]{
+		Element: element,
+	})
+}
+
+func (w *WeightedGraph[N, E]) Set(id int, element N) {
+	node := w.nodes.Get(id)
+	node.Element = element
+	w.nodes.Set(id, node)
+}
+
+// Remove removes the node and every edge touching it.
+func (w *WeightedGraph[N, E]) Remove(id int) {
+	node := w.nodes.Get(id)
+	for len(node.Edges) > 0 {
+		w.Disconnect(node.Edges[0])
+		node = w.nodes.Get(id)
+	}
+	w.nodes.Erase(id)
+}
+
+func (w *WeightedGraph[N, E]) Get(id int) WeightedNode[N] {
+	return w.nodes.Get(id)
+}
+
+// Contains reports whether id is a node that has not been removed.
+func (w *WeightedGraph[N, E]) Contains(id int) bool {
+	return w.nodes.Valid(id)
+}
+
+// Connect adds an edge between two nodes and returns its id.
+// Nodes may be connected by more than one edge.
+func (w *WeightedGraph[N, E]) Connect(a, b int, data E) int {
+	id := w.edges.Insert(Edge[E]{From: a, To: b, Data: data})
+
+	aNode := w.nodes.Get(a)
+	aNode.Edges = append(aNode.Edges, id)
+	w.nodes.Set(a, aNode)
+
+	// a loop is only listed once
+	if a != b {
+		bNode := w.nodes.Get(b)
+		bNode.Edges = append(bNode.Edges, id)
+		w.nodes.Set(b, bNode)
+	}
+
+	return id
+}
+
+// Disconnect removes the edge.
+func (w *WeightedGraph[N, E]) Disconnect(edge int) {
+	e := w.edges.Get(edge)
+	for _, n := range [2]int{e.From, e.To} {
+		node := w.nodes.Get(n)
+		if i, found := utils.Find(node.Edges, edge); found {
+			node.Edges = utils.RemoveAt(node.Edges, i)
+			w.nodes.Set(n, node)
+		}
+	}
+	w.edges.Erase(edge)
+}
+
+// Neighbors appends the node at the other end of each edge touching id.
+func (w *WeightedGraph[N, E]) Neighbors(results *[]int, id int) {
+	for _, e := range w.nodes.Get(id).Edges {
+		*results = append(*results, w.edges.Get(e).Other(id))
+	}
+}
+
+func (w *WeightedGraph[N, E]) Edge(id int) Edge[E] {
+	return w.edges.Get(id)
+}
+
+func (w *WeightedGraph[N, E]) SetEdge(id int, data E) {
+	e := w.edges.Get(id)
+	e.Data = data
+	w.edges.Set(id, e)
+}
+
+// ContainsEdge reports whether id is an edge that has not been removed.
+func (w *WeightedGraph[N, E]) ContainsEdge(id int) bool {
+	return w.edges.Valid(id)
+}
+
+// FindEdge returns the id of an edge between two nodes, in either direction.
+func (w *WeightedGraph[N, E]) FindEdge(a, b int) (int, bool) {
+	for _, id := range w.nodes.Get(a).Edges {
+		if w.edges.Get(id).Other(a) == b {
+			return id, true
+		}
+	}
+	return -1, false
+}
+
+func (w *WeightedGraph[N, E]) Clear() {
+	w.nodes.Clear()
+	w.edges.Clear()
+}
+
+// Len returns the number of node ids in use, including removed nodes whose ids are free.
+func (w *WeightedGraph[N, E]) Len() int {
+	return w.nodes.Len()
+}
+
+// EdgeLen returns the number of edge ids in use, including removed edges whose ids are free.
+func (w *WeightedGraph[N, E]) EdgeLen() int {
+	return w.edges.Len()
+}
@@ -42,3 +42,128 @@ func TestUndirectedGraph_Merge(t *testing.T) {
 	assert.ElementsMatch(t, []int{0, 3, 4}, graph.Get(1).Adjacent)
 	assert.ElementsMatch(t, []int{1, 4}, graph.Get(3).Adjacent)
 }
+
+func TestFreeList_Valid(t *testing.T) {
+	list := NewFreeList[string]()
+	a := list.Insert("a")
+	b := list.Insert("b")
+	assert.True(t, list.Valid(a))
+	assert.False(t, list.Valid(-1))
+	assert.False(t, list.Valid(2))
+
+	list.Erase(a)
+	assert.False(t, list.Valid(a))
+	assert.True(t, list.Valid(b))
+
+	assert.Equal(t, a, list.Insert("c"))
+	assert.True(t, list.Valid(a))
+}
+
+func TestUndirectedGraph_Contains(t *testing.T) {
+	graph := NewUndirectedGraph[uint64]()
+	graph.Insert(0)
+	graph.Insert(1)
+	graph.Remove(0)
+
+	assert.False(t, graph.Contains(0))
+	assert.True(t, graph.Contains(1))
+}
+
+func TestDirectedGraph(t *testing.T) {
+	graph := NewDirectedGraph[string, float64]()
+	a := graph.Insert("a")
+	b := graph.Insert("b")
+	c := graph.Insert("c")
+
+	ab := graph.Connect(a, b, 1)
+	bc := graph.Connect(b, c, 2)
+	ca := graph.Connect(c, a, 3)
+	cc := graph.Connect(c, c, 4)
+
+	assert.ElementsMatch(t, []int{ab}, graph.Get(a).Out)
+	assert.ElementsMatch(t, []int{ca}, graph.Get(a).In)
+	assert.ElementsMatch(t, []int{ca, cc}, graph.Get(c).Out)
+	assert.ElementsMatch(t, []int{bc, cc}, graph.Get(c).In)
+	assert.Equal(t, Edge[float64]{From: b, To: c, Data: 2}, graph.Edge(bc))
+
+	id, found := graph.FindEdge(a, b)
+	assert.True(t, found)
+	assert.Equal(t, ab, id)
+	_, found = graph.FindEdge(b, a)
+	assert.False(t, found)
+
+	graph.SetEdge(ab, 10)
+	assert.Equal(t, 10.0, graph.Edge(ab).Data)
+	graph.Set(a, "start")
+	assert.Equal(t, "start", graph.Get(a).Element)
+
+	graph.Disconnect(ab)
+	assert.False(t, graph.ContainsEdge(ab))
+	assert.Empty(t, graph.Get(a).Out)
+	assert.Empty(t, graph.Get(b).In)
+
+	// removing a node removes its edges, and other ids stay put
+	graph.Remove(c)
+	assert.False(t, graph.Contains(c))
+	assert.False(t, graph.ContainsEdge(bc))
+	assert.False(t, graph.ContainsEdge(ca))
+	assert.False(t, graph.ContainsEdge(cc))
+	assert.Empty(t, graph.Get(a).In)
+	assert.Empty(t, graph.Get(b).Out)
+	assert.Equal(t, "b", graph.Get(b).Element)
+	assert.Equal(t, 3, graph.Len())
+
+	// freed ids are reused
+	assert.Equal(t, c, graph.Insert("d"))
+	assert.Contains(t, []int{ab, bc, ca, cc}, graph.Connect(a, b, 5))
+	assert.Equal(t, 4, graph.EdgeLen())
+
+	graph.Clear()
+	assert.Zero(t, graph.Len())
+	assert.Zero(t, graph.EdgeLen())
+}
+
+func TestWeightedGraph(t *testing.T) {
+	type road struct {
+		length float64
+		paved  bool
+	}
+	graph := NewWeightedGraph[string, road]()
+	a := graph.Insert("a")
+	b := graph.Insert("b")
+	c := graph.Insert("c")
+
+	ab := graph.Connect(a, b, road{length: 1, paved: true})
+	bc := graph.Connect(b, c, road{length: 2})
+	ac := graph.Connect(a, c, road{length: 5})
+	loop := graph.Connect(b, b, road{length: 1})
+
+	assert.ElementsMatch(t, []int{ab, ac}, graph.Get(a).Edges)
+	assert.ElementsMatch(t, []int{ab, bc, loop}, graph.Get(b).Edges)
+	assert.Equal(t, c, graph.Edge(bc).Other(b))
+	assert.Equal(t, b, graph.Edge(bc).Other(c))
+
+	id, found := graph.FindEdge(c, a)
+	assert.True(t, found)
+	assert.Equal(t, ac, id)
+
+	graph.SetEdge(bc, road{length: 2, paved: true})
+	assert.True(t, graph.Edge(bc).Data.paved)
+
+	graph.Disconnect(ac)
+	assert.False(t, graph.ContainsEdge(ac))
+	assert.ElementsMatch(t, []int{ab}, graph.Get(a).Edges)
+	assert.ElementsMatch(t, []int{bc}, graph.Get(c).Edges)
+	_, found = graph.FindEdge(a, c)
+	assert.False(t, found)
+
+	graph.Remove(b)
+	assert.False(t, graph.Contains(b))
+	assert.False(t, graph.ContainsEdge(ab))
+	assert.False(t, graph.ContainsEdge(bc))
+	assert.False(t, graph.ContainsEdge(loop))
+	assert.Empty(t, graph.Get(a).Edges)
+	assert.Empty(t, graph.Get(c).Edges)
+	assert.True(t, graph.Contains(a))
+	assert.True(t, graph.Contains(c))
+}
@@ -0,0 +1,214 @@
+package data
+
+// CostFunc returns the cost of moving between two adjacent nodes. Costs must not be negative.
+type CostFunc func(from, to int) float64
+
+// HeuristicFunc estimates the cost from a node to the goal. A* finds the cheapest path when the
+// estimate never exceeds the true cost. It is fastest when the estimate is also consistent, never
+// dropping by more than the cost of an edge, as then no node has to be explored twice.
+type HeuristicFunc func(node, goal int) float64
+
+type searchEntry struct {
+	node     int
+	cost     float64
+	priority float64
+}
+
+// GraphSearch holds the scratch state for graph searches. Once it has grown to the size of the
+// graphs it is used with, repeated searches do not allocate. It is not safe for concurrent use.
+type GraphSearch struct {
+	cost       []float64
+	parent     []int
+	seen       []uint32
+	closed     []uint32
+	generation uint32
+	open       []searchEntry
+	queue      []int
+	adj        []int
+}
+
+func NewGraphSearch() *GraphSearch {
+	return &GraphSearch{}
+}
+
+// BFS appends to path the nodes from start to goal with the fewest edges.
+// It returns false, leaving path unchanged, if goal cannot be reached.
+func BFS(s *GraphSearch, g Graph, start, goal int, path *[]int) bool {
+	s.reset(g.Len())
+	s.visit(start, -1, 0)
+	s.queue = append(s.queue[:0], start)
+
+	for head := 0; head < len(s.queue); head++ {
+		node := s.queue[head]
+		if node == goal {
+			s.buildPath(path, goal)
+			return true
+		}
+		s.adj = s.adj[:0]
+		g.Neighbors(&s.adj, node)
+		for _, next := range s.adj {
+			if s.seen[next] != s.generation {
+				s.visit(next, node, s.cost[node]+1)
+				s.queue = append(s.queue, next)
+			}
+		}
+	}
+	return false
+}
+
+// Dijkstra appends to path the cheapest nodes from start to goal and returns the total cost.
+// A nil cost counts every edge as 1. It returns false, leaving path unchanged, if goal cannot be
+// reached.
+func Dijkstra(s *GraphSearch, g Graph, start, goal int, cost CostFunc, path *[]int) (float64, bool) {
+	return AStar(s, g, start, goal, cost, nil, path)
+}
+
+// AStar appends to path the cheapest nodes from start to goal and returns the total cost,
+// exploring towards the goal first as guided by heuristic. A nil cost counts every edge as 1 and
+// a nil heuristic searches like Dijkstra. It returns false, leaving path unchanged, if goal cannot
+// be reached.
+func AStar(s *GraphSearch, g Graph, start, goal int, cost CostFunc, heuristic HeuristicFunc, path *[]int) (float64, bool) {
+	s.reset(g.Len())
+	s.open = s.open[:0]
+	s.start(start, estimate(heuristic, start, goal))
+	if !s.search(g, goal, cost, heuristic, false) {
+		return 0, false
+	}
+	s.buildPath(path, goal)
+	return s.cost[goal], true
+}
+
+// start opens node as the root of a search.
+func (s *GraphSearch) start(node int, priority float64) {
+	s.visit(node, -1, 0)
+	s.push(searchEntry{node: node, priority: priority})
+}
+
+// search closes open nodes cheapest first, returning true once goal is closed or false when
+// nothing is left open. A goal of -1 closes every reachable node. Usually a node's cost is that of
+// the cheapest path to it, but when spanning it is the cheapest edge from a closed node, as in
+// Prim's algorithm.
+func (s *GraphSearch) search(g Graph, goal int, cost CostFunc, heuristic HeuristicFunc, spanning bool) bool {
+	for len(s.open) > 0 {
+		e := s.pop()
+		node := e.node
+		// skip stale entries left behind when a node was reached more cheaply
+		if s.closed[node] == s.generation || e.cost > s.cost[node] {
+			continue
+		}
+		s.closed[node] = s.generation
+		if node == goal {
+			return true
+		}
+
+		s.adj = s.adj[:0]
+		g.Neighbors(&s.adj, node)
+		for _, next := range s.adj {
+			c := edgeCost(cost, node, next)
+			if spanning {
+				if s.closed[next] == s.generation {
+					continue
+				}
+			} else {
+				c += s.cost[node]
+			}
+			if s.seen[next] != s.generation || c < s.cost[next] {
+				// an inconsistent heuristic can close a node before its cheapest path is found,
+				// so reopen it
+				s.visit(next, node, c)
+				s.closed[next] = 0
+				s.push(searchEntry{node: next, cost: c, priority: c + estimate(heuristic, next, goal)})
+			}
+		}
+	}
+	return false
+}
+
+// reset invalidates the previous search by moving to a new generation, growing the scratch
+// slices to hold n nodes.
+func (s *GraphSearch) reset(n int) {
+	if len(s.seen) < n {
+		s.cost = append(s.cost, make([]float64, n-len(s.cost))...)
+		s.parent = append(s.parent, make([]int, n-len(s.parent))...)
+		s.seen = append(s.seen, make([]uint32, n-len(s.seen))...)
+		s.closed = append(s.closed, make([]uint32, n-len(s.closed))...)
+	}
+	s.generation++
+	if s.generation == 0 {
+		// the stamps wrapped around, so clear them to avoid stale matches
+		for i := range s.seen {
+			s.seen[i] = 0
+			s.closed[i] = 0
+		}
+		s.generation = 1
+	}
+}
+
+func (s *GraphSearch) visit(node, parent int, cost float64) {
+	s.seen[node] = s.generation
+	s.parent[node] = parent
+	s.cost[node] = cost
+}
+
+// buildPath appends the path ending at node, walking parents back to the start.
+func (s *GraphSearch) buildPath(path *[]int, node int) {
+	first := len(*path)
+	for ; node != -1; node = s.parent[node] {
+		*path = append(*path, node)
+	}
+	p := *path
+	for i, j := first, len(p)-1; i < j; i, j = i+1, j-1 {
+		p[i], p[j] = p[j], p[i]
+	}
+}
+
+// push and pop keep open as a binary min heap on priority
+func (s *GraphSearch) push(e searchEntry) {
+	s.open = append(s.open, e)
+	i := len(s.open) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if s.open[parent].priority <= s.open[i].priority {
+			break
+		}
+		s.open[parent], s.open[i] = s.open[i], s.open[parent]
+		i = parent
+	}
+}
+
+func (s *GraphSearch) pop() searchEntry {
+	top := s.open[0]
+	last := len(s.open) - 1
+	s.open[0] = s.open[last]
+	s.open = s.open[:last]
+
+	i := 0
+	for {
+		smallest := i
+		if l := 2*i + 1; l < last && s.open[l].priority < s.open[smallest].priority {
+			smallest = l
+		}
+		if r := 2*i + 2; r < last && s.open[r].priority < s.open[smallest].priority {
+			smallest = r
+		}
+		if smallest == i {
+			return top
+		}
+		s.open[smallest], s.open[i] = s.open[i], s.open[smallest]
+		i = smallest
+	}
+}
+
+func edgeCost(cost CostFunc, from, to int) float64 {
+	if cost == nil {
+		return 1
+	}
+	return cost(from, to)
+}
+
+func estimate(heuristic HeuristicFunc, node, goal int) float64 {
+	if heuristic == nil {
+		return 0
+	}
+	return heuristic(node, goal)
+}
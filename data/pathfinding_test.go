@@ -0,0 +1,227 @@
+package data
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// gridGraph connects a width by height grid of cells, skipping walls.
+// Node ids are y*width + x and elements hold the cell coordinates.
+func gridGraph(width, height int, walls ...[2]int) *UndirectedGraph[[2]int] {
+	wall := map[[2]int]bool{}
+	for _, w := range walls {
+		wall[w] = true
+	}
+	graph := NewUndirectedGraph[[2]int]()
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			graph.Insert([2]int{x, y})
+		}
+	}
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if wall[[2]int{x, y}] {
+				continue
+			}
+			if x+1 < width && !wall[[2]int{x + 1, y}] {
+				graph.Connect(y*width+x, y*width+x+1)
+			}
+			if y+1 < height && !wall[[2]int{x, y + 1}] {
+				graph.Connect(y*width+x, (y+1)*width+x)
+			}
+		}
+	}
+	return graph
+}
+
+func TestBFS(t *testing.T) {
+	// a wall down column 2 with a gap at the bottom
+	graph := gridGraph(5, 5, [2]int{2, 0}, [2]int{2, 1}, [2]int{2, 2}, [2]int{2, 3})
+	s := NewGraphSearch()
+
+	path := []int{99}
+	assert.True(t, BFS(s, graph, 0, 4, &path))
+	assert.Equal(t, 99, path[0])
+	path = path[1:]
+	assert.Len(t, path, 13)
+	assert.Equal(t, 0, path[0])
+	assert.Equal(t, 4, path[len(path)-1])
+	for i := 1; i < len(path); i++ {
+		assert.Contains(t, graph.Get(path[i-1]).Adjacent, path[i])
+	}
+
+	path = path[:0]
+	assert.True(t, BFS(s, graph, 7, 7, &path))
+	assert.Equal(t, []int{7}, path)
+
+	// close the gap
+	graph.Disconnect(21, 22)
+	graph.Disconnect(22, 23)
+	graph.Disconnect(17, 22)
+	path = path[:0]
+	assert.False(t, BFS(s, graph, 0, 4, &path))
+	assert.Empty(t, path)
+}
+
+func TestDijkstra(t *testing.T) {
+	// 0 - 1 - 2 is cheap around, 0 - 2 is an expensive shortcut
+	graph := NewUndirectedGraph[string]()
+	for _, name := range []string{"a", "b", "c", "d"} {
+		graph.Insert(name)
+	}
+	graph.Connect(0, 1)
+	graph.Connect(1, 2)
+	graph.Connect(0, 2)
+	costs := map[[2]int]float64{{0, 1}: 1, {1, 2}: 2, {0, 2}: 5}
+	cost := func(a, b int) float64 {
+		if a > b {
+			a, b = b, a
+		}
+		return costs[[2]int{a, b}]
+	}
+	s := NewGraphSearch()
+
+	var path []int
+	c, ok := Dijkstra(s, graph, 0, 2, cost, &path)
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, c)
+	assert.Equal(t, []int{0, 1, 2}, path)
+
+	path = path[:0]
+	c, ok = Dijkstra(s, graph, 0, 2, nil, &path)
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, c)
+	assert.Equal(t, []int{0, 2}, path)
+
+	path = path[:0]
+	_, ok = Dijkstra(s, graph, 0, 3, cost, &path)
+	assert.False(t, ok)
+	assert.Empty(t, path)
+}
+
+func TestDijkstra_OtherGraphs(t *testing.T) {
+	// edge payloads hold the costs
+	weighted := NewWeightedGraph[string, float64]()
+	for _, name := range []string{"a", "b", "c"} {
+		weighted.Insert(name)
+	}
+	weighted.Connect(0, 1, 1)
+	weighted.Connect(1, 2, 2)
+	weighted.Connect(0, 2, 5)
+	cost := func(a, b int) float64 {
+		id, _ := weighted.FindEdge(a, b)
+		return weighted.Edge(id).Data
+	}
+	s := NewGraphSearch()
+
+	var path []int
+	c, ok := Dijkstra(s, weighted, 2, 0, cost, &path)
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, c)
+	assert.Equal(t, []int{2, 1, 0}, path)
+
+	// directed edges are only followed forwards
+	directed := directedGraph(3, [2]int{0, 1}, [2]int{1, 2}, [2]int{2, 0})
+	path = path[:0]
+	assert.True(t, BFS(s, directed, 0, 2, &path))
+	assert.Equal(t, []int{0, 1, 2}, path)
+
+	path = path[:0]
+	c, ok = AStar(s, directed, 1, 0, nil, nil, &path)
+	assert.True(t, ok)
+	assert.Equal(t, 2.0, c)
+	assert.Equal(t, []int{1, 2, 0}, path)
+}
+
+func TestAStar(t *testing.T) {
+	const width = 20
+	graph := gridGraph(width, width, [2]int{10, 5}, [2]int{10, 6}, [2]int{10, 7}, [2]int{10, 8}, [2]int{10, 9}, [2]int{10, 10}, [2]int{10, 11})
+	weight := func(a, b int) float64 {
+		// the lower half of the grid is swampy
+		if graph.Get(b).Element[1] > width/2 {
+			return 3
+		}
+		return 1
+	}
+	manhattan := func(node, goal int) float64 {
+		a, b := graph.Get(node).Element, graph.Get(goal).Element
+		return math.Abs(float64(a[0]-b[0])) + math.Abs(float64(a[1]-b[1]))
+	}
+	s := NewGraphSearch()
+
+	for _, query := range [][2]int{{8*width + 2, 8*width + 18}, {0, width*width - 1}, {15*width + 3, 2*width + 17}} {
+		var expected, actual []int
+		expectedCost, ok := Dijkstra(s, graph, query[0], query[1], weight, &expected)
+		assert.True(t, ok)
+		actualCost, ok := AStar(s, graph, query[0], query[1], weight, manhattan, &actual)
+		assert.True(t, ok)
+		assert.Equal(t, expectedCost, actualCost)
+
+		pathCost := 0.0
+		for i := 1; i < len(actual); i++ {
+			assert.Contains(t, graph.Get(actual[i-1]).Adjacent, actual[i])
+			pathCost += weight(actual[i-1], actual[i])
+		}
+		assert.Equal(t, actualCost, pathCost)
+	}
+}
+
+func TestAStar_InconsistentHeuristic(t *testing.T) {
+	// the heuristic never overestimates but drops by more than an edge from A to C,
+	// so C is first reached along the dearer route through B
+	const (
+		start = iota
+		a
+		b
+		c
+		goal
+	)
+	graph := NewUndirectedGraph[int]()
+	for i := 0; i < 5; i++ {
+		graph.Insert(i)
+	}
+	costs := map[[2]int]float64{{start, a}: 1, {start, b}: 1, {a, c}: 1, {b, c}: 2, {c, goal}: 3}
+	for e := range costs {
+		graph.Connect(e[0], e[1])
+	}
+	cost := func(from, to int) float64 {
+		if from > to {
+			from, to = to, from
+		}
+		return costs[[2]int{from, to}]
+	}
+	heuristic := func(node, _ int) float64 {
+		return map[int]float64{a: 4, b: 1}[node]
+	}
+
+	var path []int
+	total, ok := AStar(NewGraphSearch(), graph, start, goal, cost, heuristic, &path)
+	assert.True(t, ok)
+	assert.Equal(t, 5.0, total)
+	assert.Equal(t, []int{start, a, c, goal}, path)
+}
+
+func TestGraphSearch_NoAllocations(t *testing.T) {
+	graph := gridGraph(30, 30, [2]int{15, 10}, [2]int{15, 11}, [2]int{15, 12})
+	heuristic := func(node, goal int) float64 {
+		a, b := graph.Get(node).Element, graph.Get(goal).Element
+		return math.Abs(float64(a[0]-b[0])) + math.Abs(float64(a[1]-b[1]))
+	}
+	s := NewGraphSearch()
+	path := make([]int, 0, 900)
+
+	// the first searches grow the scratch space
+	BFS(s, graph, 0, 899, &path)
+	Dijkstra(s, graph, 0, 899, nil, &path)
+	allocs := testing.AllocsPerRun(20, func() {
+		path = path[:0]
+		BFS(s, graph, 0, 899, &path)
+		path = path[:0]
+		Dijkstra(s, graph, 0, 899, nil, &path)
+		path = path[:0]
+		AStar(s, graph, 31, 870, nil, heuristic, &path)
+	})
+	assert.Zero(t, allocs)
+}
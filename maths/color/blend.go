@@ -0,0 +1,64 @@
+package color
+
+import "math"
+
+// BlendMode decides how a source color combines with the destination beneath it.
+type BlendMode int
+
+const (
+	BlendNormal BlendMode = iota
+	BlendMultiply
+	BlendScreen
+	BlendOverlay
+	BlendDarken
+	BlendLighten
+	BlendAdd
+	BlendSubtract
+	BlendDifference
+)
+
+// Blend composites src over dst with the given mode, using the W3C compositing formulas on
+// sRGB components as image editors do. Where dst is transparent, src shows unblended.
+func Blend(dst, src Color, mode BlendMode) Color {
+	alpha := src.A + dst.A*(1-src.A)
+	if alpha <= 0 {
+		return Transparent
+	}
+	channel := func(cb, cs float64) float64 {
+		mixed := (1-dst.A)*cs + dst.A*blendChannel(cb, cs, mode)
+		return (src.A*mixed + dst.A*cb*(1-src.A)) / alpha
+	}
+	return Color{
+		R: channel(dst.R, src.R),
+		G: channel(dst.G, src.G),
+		B: channel(dst.B, src.B),
+		A: alpha,
+	}
+}
+
+func blendChannel(cb, cs float64, mode BlendMode) float64 {
+	switch mode {
+	case BlendMultiply:
+		return cb * cs
+	case BlendScreen:
+		return cb + cs - cb*cs
+	case BlendOverlay:
+		// hard light with the layers swapped
+		if cb <= 0.5 {
+			return 2 * cb * cs
+		}
+		return 1 - 2*(1-cb)*(1-cs)
+	case BlendDarken:
+		return math.Min(cb, cs)
+	case BlendLighten:
+		return math.Max(cb, cs)
+	case BlendAdd:
+		return math.Min(cb+cs, 1)
+	case BlendSubtract:
+		return math.Max(cb-cs, 0)
+	case BlendDifference:
+		return math.Abs(cb - cs)
+	default:
+		return cs
+	}
+}
@@ -0,0 +1,145 @@
+// Package color is a floating point RGBA color shared by rendering, UI and particle code.
+package color
+
+import (
+	"image/color"
+	"math"
+)
+
+// Color holds sRGB encoded components with straight, not premultiplied, alpha.
+// Components are usually between 0 and 1 but may go outside that range, for example for HDR.
+type Color struct {
+	R float64
+	G float64
+	B float64
+	A float64
+}
+
+var (
+	Transparent = Color{}
+	Black       = Color{A: 1}
+	White       = Color{R: 1, G: 1, B: 1, A: 1}
+)
+
+func New(r, g, b, a float64) Color {
+	return Color{R: r, G: g, B: b, A: a}
+}
+
+// RGB returns an opaque color.
+func RGB(r, g, b float64) Color {
+	return Color{R: r, G: g, B: b, A: 1}
+}
+
+// Hex returns an opaque color from a 0xRRGGBB value.
+func Hex(rgb uint32) Color {
+	return HexA(rgb<<8 | 0xff)
+}
+
+// HexA returns a color from a 0xRRGGBBAA value.
+func HexA(rgba uint32) Color {
+	return Color{
+		R: float64(rgba>>24&0xff) / 255,
+		G: float64(rgba>>16&0xff) / 255,
+		B: float64(rgba>>8&0xff) / 255,
+		A: float64(rgba&0xff) / 255,
+	}
+}
+
+// FromColor converts any image/color value.
+func FromColor(c color.Color) Color {
+	switch c := c.(type) {
+	case Color:
+		return c
+	case color.NRGBA:
+		return Color{R: float64(c.R) / 255, G: float64(c.G) / 255, B: float64(c.B) / 255, A: float64(c.A) / 255}
+	}
+
+	r, g, b, a := c.RGBA()
+	if a == 0 {
+		return Transparent
+	}
+	alpha := float64(a)
+	return Color{R: float64(r) / alpha, G: float64(g) / alpha, B: float64(b) / alpha, A: alpha / 0xffff}
+}
+
+// RGBA implements image/color.Color, returning alpha premultiplied 16 bit components.
+func (c Color) RGBA() (r, g, b, a uint32) {
+	c = c.Clamp()
+	a = uint32(c.A*0xffff + 0.5)
+	r = uint32(c.R*c.A*0xffff + 0.5)
+	g = uint32(c.G*c.A*0xffff + 0.5)
+	b = uint32(c.B*c.A*0xffff + 0.5)
+	return r, g, b, a
+}
+
+// NRGBA8 returns the color as straight alpha 8 bit components.
+func (c Color) NRGBA8() color.NRGBA {
+	c = c.Clamp()
+	return color.NRGBA{R: to8(c.R), G: to8(c.G), B: to8(c.B), A: to8(c.A)}
+}
+
+// RGBA8 returns the color as premultiplied 8 bit components.
+func (c Color) RGBA8() color.RGBA {
+	c = c.Clamp()
+	return color.RGBA{R: to8(c.R * c.A), G: to8(c.G * c.A), B: to8(c.B * c.A), A: to8(c.A)}
+}
+
+// Hex returns the color as a 0xRRGGBBAA value.
+func (c Color) Hex() uint32 {
+	n := c.NRGBA8()
+	return uint32(n.R)<<24 | uint32(n.G)<<16 | uint32(n.B)<<8 | uint32(n.A)
+}
+
+func (c Color) WithAlpha(a float64) Color {
+	c.A = a
+	return c
+}
+
+// Clamp limits every component to [0, 1].
+func (c Color) Clamp() Color {
+	return Color{R: clamp01(c.R), G: clamp01(c.G), B: clamp01(c.B), A: clamp01(c.A)}
+}
+
+func (c Color) Add(c2 Color) Color {
+	return Color{R: c.R + c2.R, G: c.G + c2.G, B: c.B + c2.B, A: c.A + c2.A}
+}
+
+// Multiply multiplies component by component, which tints c by c2.
+func (c Color) Multiply(c2 Color) Color {
+	return Color{R: c.R * c2.R, G: c.G * c2.G, B: c.B * c2.B, A: c.A * c2.A}
+}
+
+// Scale multiplies the color components, leaving alpha unchanged.
+func (c Color) Scale(scalar float64) Color {
+	return Color{R: c.R * scalar, G: c.G * scalar, B: c.B * scalar, A: c.A}
+}
+
+// Lerp interpolates every component, including alpha, in sRGB space.
+func (c Color) Lerp(c2 Color, t float64) Color {
+	return Color{
+		R: c.R + (c2.R-c.R)*t,
+		G: c.G + (c2.G-c.G)*t,
+		B: c.B + (c2.B-c.B)*t,
+		A: c.A + (c2.A-c.A)*t,
+	}
+}
+
+// ApproxEqual reports whether each component of c is within epsilon of c2.
+func (c Color) ApproxEqual(c2 Color, epsilon float64) bool {
+	return math.Abs(c.R-c2.R) <= epsilon && math.Abs(c.G-c2.G) <= epsilon &&
+		math.Abs(c.B-c2.B) <= epsilon && math.Abs(c.A-c2.A) <= epsilon
+}
+
+func to8(v float64) uint8 {
+	return uint8(v*255 + 0.5)
+}
+
+func clamp01(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
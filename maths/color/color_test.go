@@ -0,0 +1,186 @@
+package color_test
+
+import (
+	"image"
+	imagecolor "image/color"
+	"testing"
+
+	"github.com/soupstoregames/gamelib/maths/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertColorInDelta(t *testing.T, expected, actual color.Color, delta float64) {
+	t.Helper()
+	assert.True(t, expected.ApproxEqual(actual, delta), "expected %v, got %v", expected, actual)
+}
+
+func TestColor_Hex(t *testing.T) {
+	c := color.Hex(0xff8000)
+	assertColorInDelta(t, color.New(1, 128.0/255, 0, 1), c, 1e-12)
+	assert.Equal(t, uint32(0xff8000ff), c.Hex())
+	assert.Equal(t, uint32(0x12345678), color.HexA(0x12345678).Hex())
+}
+
+func TestColor_ImageColor(t *testing.T) {
+	c := color.New(1, 0.5, 0.25, 0.5)
+	assert.Equal(t, imagecolor.NRGBA{R: 255, G: 128, B: 64, A: 128}, c.NRGBA8())
+	assert.Equal(t, imagecolor.RGBA{R: 128, G: 64, B: 32, A: 128}, c.RGBA8())
+
+	// Color is an image/color.Color and can be drawn directly
+	img := image.NewRGBA(image.Rect(0, 0, 1, 1))
+	img.Set(0, 0, c)
+	assert.Equal(t, imagecolor.RGBA{R: 128, G: 64, B: 32, A: 128}, img.RGBAAt(0, 0))
+
+	assertColorInDelta(t, c, color.FromColor(c), 0)
+	assertColorInDelta(t, color.New(1, 128.0/255, 64.0/255, 128.0/255), color.FromColor(c.NRGBA8()), 1e-12)
+	assertColorInDelta(t, c, color.FromColor(c.RGBA8()), 0.01)
+	assert.Equal(t, color.Transparent, color.FromColor(imagecolor.RGBA{}))
+	assertColorInDelta(t, color.White, color.FromColor(imagecolor.White), 0)
+
+	// out of range components are clamped on conversion
+	assert.Equal(t, imagecolor.NRGBA{R: 255, A: 255}, color.New(2, -1, 0, 1).NRGBA8())
+}
+
+func TestColor_Linear(t *testing.T) {
+	assert.InDelta(t, 0.214041, color.SRGBToLinear(0.5), 1e-6)
+	assert.InDelta(t, 0.5, color.LinearToSRGB(0.214041), 1e-6)
+	assert.Equal(t, 0.0, color.SRGBToLinear(0))
+	assert.InDelta(t, 1, color.SRGBToLinear(1), 1e-12)
+
+	for _, v := range []float64{0, 0.001, 0.04, 0.2, 0.5, 0.9, 1} {
+		assert.InDelta(t, v, color.LinearToSRGB(color.SRGBToLinear(v)), 1e-12)
+	}
+	c := color.New(0.1, 0.5, 0.9, 0.3)
+	assertColorInDelta(t, c, c.ToLinear().ToSRGB(), 1e-12)
+	assert.Equal(t, 0.3, c.ToLinear().A)
+}
+
+func TestColor_HSV(t *testing.T) {
+	cases := map[string]struct {
+		color   color.Color
+		h, s, v float64
+	}{
+		"red":    {color.RGB(1, 0, 0), 0, 1, 1},
+		"green":  {color.RGB(0, 1, 0), 120, 1, 1},
+		"blue":   {color.RGB(0, 0, 1), 240, 1, 1},
+		"purple": {color.RGB(0.5, 0, 0.5), 300, 1, 0.5},
+		"grey":   {color.RGB(0.5, 0.5, 0.5), 0, 0, 0.5},
+		"black":  {color.Black, 0, 0, 0},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			h, s, v := c.color.HSV()
+			assert.InDelta(t, c.h, h, 1e-9)
+			assert.InDelta(t, c.s, s, 1e-9)
+			assert.InDelta(t, c.v, v, 1e-9)
+			assertColorInDelta(t, c.color, color.FromHSV(h, s, v, 1), 1e-12)
+		})
+	}
+	assertColorInDelta(t, color.RGB(1, 0, 0), color.FromHSV(720, 1, 1, 1), 1e-12)
+	assertColorInDelta(t, color.RGB(1, 0, 1), color.FromHSV(-60, 1, 1, 1), 1e-12)
+}
+
+func TestColor_HSL(t *testing.T) {
+	cases := map[string]struct {
+		color   color.Color
+		h, s, l float64
+	}{
+		"red":   {color.RGB(1, 0, 0), 0, 1, 0.5},
+		"pink":  {color.RGB(1, 0.5, 0.5), 0, 1, 0.75},
+		"teal":  {color.RGB(0, 0.5, 0.5), 180, 1, 0.25},
+		"white": {color.White, 0, 0, 1},
+		"grey":  {color.RGB(0.25, 0.25, 0.25), 0, 0, 0.25},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			h, s, l := c.color.HSL()
+			assert.InDelta(t, c.h, h, 1e-9)
+			assert.InDelta(t, c.s, s, 1e-9)
+			assert.InDelta(t, c.l, l, 1e-9)
+			assertColorInDelta(t, c.color, color.FromHSL(h, s, l, 1), 1e-12)
+		})
+	}
+}
+
+func TestColor_OKLab(t *testing.T) {
+	l, a, b := color.White.OKLab()
+	assert.InDelta(t, 1, l, 1e-6)
+	assert.InDelta(t, 0, a, 1e-6)
+	assert.InDelta(t, 0, b, 1e-6)
+
+	// reference values for pure red
+	l, a, b = color.RGB(1, 0, 0).OKLab()
+	assert.InDelta(t, 0.62796, l, 1e-4)
+	assert.InDelta(t, 0.22486, a, 1e-4)
+	assert.InDelta(t, 0.12585, b, 1e-4)
+
+	for _, c := range []color.Color{color.RGB(0.2, 0.4, 0.6), color.New(0.9, 0.1, 0.5, 0.5), color.Black} {
+		l, a, b := c.OKLab()
+		assertColorInDelta(t, c, color.FromOKLab(l, a, b, c.A), 1e-6)
+	}
+}
+
+func TestBlend(t *testing.T) {
+	dst := color.RGB(0.2, 0.4, 0.6)
+	src := color.RGB(0.5, 0.5, 0.5)
+
+	cases := map[color.BlendMode]color.Color{
+		color.BlendNormal:     src,
+		color.BlendMultiply:   color.RGB(0.1, 0.2, 0.3),
+		color.BlendScreen:     color.RGB(0.6, 0.7, 0.8),
+		color.BlendOverlay:    color.RGB(0.2, 0.4, 0.6),
+		color.BlendDarken:     color.RGB(0.2, 0.4, 0.5),
+		color.BlendLighten:    color.RGB(0.5, 0.5, 0.6),
+		color.BlendAdd:        color.RGB(0.7, 0.9, 1),
+		color.BlendSubtract:   color.RGB(0, 0, 0.1),
+		color.BlendDifference: color.RGB(0.3, 0.1, 0.1),
+	}
+	for mode, expected := range cases {
+		assertColorInDelta(t, expected, color.Blend(dst, src, mode), 1e-12)
+
+		// a transparent destination shows the source unblended, and a transparent source changes nothing
+		assertColorInDelta(t, src, color.Blend(color.Transparent, src, mode), 1e-12)
+		assertColorInDelta(t, dst, color.Blend(dst, color.Transparent, mode), 1e-12)
+	}
+
+	assertColorInDelta(t, color.New(0.25, 0, 0, 1), color.Blend(color.Black, color.New(0.5, 0, 0, 0.5), color.BlendNormal), 1e-12)
+	assertColorInDelta(t, color.New(1, 0, 0, 0.75), color.Blend(color.New(1, 0, 0, 0.5), color.New(1, 0, 0, 0.5), color.BlendNormal), 1e-12)
+	assert.Equal(t, color.Transparent, color.Blend(color.Transparent, color.Transparent, color.BlendMultiply))
+}
+
+func TestGradient(t *testing.T) {
+	g := color.NewGradient(
+		color.Stop{Position: 1, Color: color.White},
+		color.Stop{Position: 0, Color: color.Black},
+		color.Stop{Position: 0.5, Color: color.RGB(1, 0, 0)},
+	)
+	assertColorInDelta(t, color.Black, g.At(-1), 0)
+	assertColorInDelta(t, color.Black, g.At(0), 0)
+	assertColorInDelta(t, color.RGB(0.5, 0, 0), g.At(0.25), 1e-12)
+	assertColorInDelta(t, color.RGB(1, 0, 0), g.At(0.5), 0)
+	assertColorInDelta(t, color.RGB(1, 0.5, 0.5), g.At(0.75), 1e-12)
+	assertColorInDelta(t, color.White, g.At(2), 0)
+
+	// linear light mixing is brighter between black and white
+	g = color.NewEvenGradient(color.Black, color.White)
+	g.Interpolation = color.InterpolateLinear
+	assert.InDelta(t, 0.735, g.At(0.5).R, 1e-3)
+
+	// perceptual mixing keeps lightness even
+	g.Interpolation = color.InterpolateOKLab
+	l, _, _ := g.At(0.5).OKLab()
+	assert.InDelta(t, 0.5, l, 1e-6)
+
+	// a hard edge where two stops share a position
+	g = color.NewGradient(
+		color.Stop{Position: 0, Color: color.Black},
+		color.Stop{Position: 0.5, Color: color.Black},
+		color.Stop{Position: 0.5, Color: color.White},
+		color.Stop{Position: 1, Color: color.White},
+	)
+	assertColorInDelta(t, color.Black, g.At(0.49), 0)
+	assertColorInDelta(t, color.White, g.At(0.5), 0)
+
+	assert.Equal(t, color.Transparent, color.Gradient{}.At(0.5))
+	assert.Equal(t, color.White, color.NewEvenGradient(color.White).At(0.3))
+}
@@ -0,0 +1,128 @@
+package color
+
+import "math"
+
+// SRGBToLinear decodes an sRGB component to linear light.
+func SRGBToLinear(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+// LinearToSRGB encodes a linear light component as sRGB.
+func LinearToSRGB(v float64) float64 {
+	if v <= 0.0031308 {
+		return v * 12.92
+	}
+	return 1.055*math.Pow(v, 1/2.4) - 0.055
+}
+
+// ToLinear returns the components in linear light, where lighting and mixing are physically correct.
+// Alpha is unchanged.
+func (c Color) ToLinear() Color {
+	return Color{R: SRGBToLinear(c.R), G: SRGBToLinear(c.G), B: SRGBToLinear(c.B), A: c.A}
+}
+
+// ToSRGB encodes linear light components as sRGB. It is the inverse of ToLinear.
+func (c Color) ToSRGB() Color {
+	return Color{R: LinearToSRGB(c.R), G: LinearToSRGB(c.G), B: LinearToSRGB(c.B), A: c.A}
+}
+
+// HSV returns hue in degrees in [0, 360), saturation and value.
+func (c Color) HSV() (h, s, v float64) {
+	max := math.Max(c.R, math.Max(c.G, c.B))
+	min := math.Min(c.R, math.Min(c.G, c.B))
+	h = hue(c, max, min)
+	if max > 0 {
+		s = (max - min) / max
+	}
+	return h, s, max
+}
+
+// FromHSV returns a color from hue in degrees, saturation, value and alpha.
+func FromHSV(h, s, v, alpha float64) Color {
+	// f(n) = v - v*s*max(0, min(k, 4-k, 1)) with k = (n + h/60) mod 6
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/60, 6)
+		if k < 0 {
+			k += 6
+		}
+		return v - v*s*math.Max(0, math.Min(k, math.Min(4-k, 1)))
+	}
+	return Color{R: f(5), G: f(3), B: f(1), A: alpha}
+}
+
+// HSL returns hue in degrees in [0, 360), saturation and lightness.
+func (c Color) HSL() (h, s, l float64) {
+	max := math.Max(c.R, math.Max(c.G, c.B))
+	min := math.Min(c.R, math.Min(c.G, c.B))
+	h = hue(c, max, min)
+	l = (max + min) / 2
+	if d := 1 - math.Abs(2*l-1); d > 0 {
+		s = (max - min) / d
+	}
+	return h, s, l
+}
+
+// FromHSL returns a color from hue in degrees, saturation, lightness and alpha.
+func FromHSL(h, s, l, alpha float64) Color {
+	a := s * math.Min(l, 1-l)
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/30, 12)
+		if k < 0 {
+			k += 12
+		}
+		return l - a*math.Max(-1, math.Min(k-3, math.Min(9-k, 1)))
+	}
+	return Color{R: f(0), G: f(8), B: f(4), A: alpha}
+}
+
+// OKLab returns the perceptual lightness and the green-red and blue-yellow axes of the OKLab
+// color space. Equal distances in OKLab look like equal differences in color.
+func (c Color) OKLab() (l, a, b float64) {
+	lin := c.ToLinear()
+	lc := math.Cbrt(0.4122214708*lin.R + 0.5363325363*lin.G + 0.0514459929*lin.B)
+	mc := math.Cbrt(0.2119034982*lin.R + 0.6806995451*lin.G + 0.1073969566*lin.B)
+	sc := math.Cbrt(0.0883024619*lin.R + 0.2817188376*lin.G + 0.6299787005*lin.B)
+	return 0.2104542553*lc + 0.7936177850*mc - 0.0040720468*sc,
+		1.9779984951*lc - 2.4285922050*mc + 0.4505937099*sc,
+		0.0259040371*lc + 0.7827717662*mc - 0.8086757660*sc
+}
+
+// FromOKLab returns a color from OKLab coordinates and alpha. Colors outside the sRGB gamut
+// have components outside [0, 1].
+func FromOKLab(l, a, b, alpha float64) Color {
+	lc := l + 0.3963377774*a + 0.2158037573*b
+	mc := l - 0.1055613458*a - 0.0638541728*b
+	sc := l - 0.0894841775*a - 1.2914855480*b
+	lc, mc, sc = lc*lc*lc, mc*mc*mc, sc*sc*sc
+	return Color{
+		R: 4.0767416621*lc - 3.3077115913*mc + 0.2309699292*sc,
+		G: -1.2684380046*lc + 2.6097574011*mc - 0.3413193965*sc,
+		B: -0.0041960863*lc - 0.7034186147*mc + 1.7076147010*sc,
+		A: alpha,
+	}.ToSRGB()
+}
+
+// hue returns the hue in degrees shared by HSV and HSL.
+func hue(c Color, max, min float64) float64 {
+	d := max - min
+	if d == 0 {
+		return 0
+	}
+	var h float64
+	switch max {
+	case c.R:
+		h = math.Mod((c.G-c.B)/d, 6)
+	case c.G:
+		h = (c.B-c.R)/d + 2
+	default:
+		h = (c.R-c.G)/d + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
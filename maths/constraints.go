@@ -0,0 +1,9 @@
+package maths
+
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~float32 | ~float64
+}
+
+type Float interface {
+	~float32 | ~float64
+}
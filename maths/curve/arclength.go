@@ -0,0 +1,59 @@
+package curve
+
+import "sort"
+
+// ArcLength maps distances along a curve to curve parameters so the curve can be traversed at a
+// constant speed. The mapping is a lookup table built from evenly spaced samples of t.
+type ArcLength[V Vector[V]] struct {
+	curve   Curve[V]
+	lengths []float64
+}
+
+// NewArcLength measures the curve using the given number of straight segments.
+// More samples are more accurate for tightly bending curves.
+func NewArcLength[V Vector[V]](c Curve[V], samples int) ArcLength[V] {
+	if samples < 1 {
+		samples = 1
+	}
+	lengths := make([]float64, samples+1)
+	prev := c.At(0)
+	for i := 1; i <= samples; i++ {
+		p := c.At(float64(i) / float64(samples))
+		lengths[i] = lengths[i-1] + p.Distance(prev)
+		prev = p
+	}
+	return ArcLength[V]{curve: c, lengths: lengths}
+}
+
+func (a ArcLength[V]) Length() float64 {
+	return a.lengths[len(a.lengths)-1]
+}
+
+// Parameter returns the curve parameter at distance along the curve, clamped to the ends.
+func (a ArcLength[V]) Parameter(distance float64) float64 {
+	samples := len(a.lengths) - 1
+	if distance <= 0 {
+		return 0
+	}
+	if distance >= a.Length() {
+		return 1
+	}
+
+	i := sort.SearchFloat64s(a.lengths, distance)
+	before, after := a.lengths[i-1], a.lengths[i]
+	fraction := 0.0
+	if after > before {
+		fraction = (distance - before) / (after - before)
+	}
+	return (float64(i-1) + fraction) / float64(samples)
+}
+
+// At returns the position at distance along the curve.
+func (a ArcLength[V]) At(distance float64) V {
+	return a.curve.At(a.Parameter(distance))
+}
+
+// Tangent returns the direction of travel at distance along the curve.
+func (a ArcLength[V]) Tangent(distance float64) V {
+	return Tangent(a.curve, a.Parameter(distance))
+}
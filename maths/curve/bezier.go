@@ -0,0 +1,59 @@
+package curve
+
+// QuadraticBezier is a curve from P0 to P2 pulled towards P1.
+type QuadraticBezier[V Vector[V]] struct {
+	P0, P1, P2 V
+}
+
+func (b QuadraticBezier[V]) At(t float64) V {
+	u := 1 - t
+	return b.P0.Multiply(u * u).Add(b.P1.Multiply(2 * u * t)).Add(b.P2.Multiply(t * t))
+}
+
+func (b QuadraticBezier[V]) Derivative(t float64) V {
+	return b.P1.Sub(b.P0).Multiply(2 * (1 - t)).Add(b.P2.Sub(b.P1).Multiply(2 * t))
+}
+
+// Split divides the curve at t into two curves that trace the same path.
+func (b QuadraticBezier[V]) Split(t float64) (QuadraticBezier[V], QuadraticBezier[V]) {
+	// de Casteljau's algorithm
+	p01 := lerp(b.P0, b.P1, t)
+	p12 := lerp(b.P1, b.P2, t)
+	mid := lerp(p01, p12, t)
+	return QuadraticBezier[V]{P0: b.P0, P1: p01, P2: mid}, QuadraticBezier[V]{P0: mid, P1: p12, P2: b.P2}
+}
+
+// CubicBezier is a curve from P0 to P3 that leaves towards P1 and arrives from P2.
+type CubicBezier[V Vector[V]] struct {
+	P0, P1, P2, P3 V
+}
+
+func (b CubicBezier[V]) At(t float64) V {
+	u := 1 - t
+	return b.P0.Multiply(u * u * u).
+		Add(b.P1.Multiply(3 * u * u * t)).
+		Add(b.P2.Multiply(3 * u * t * t)).
+		Add(b.P3.Multiply(t * t * t))
+}
+
+func (b CubicBezier[V]) Derivative(t float64) V {
+	u := 1 - t
+	return b.P1.Sub(b.P0).Multiply(3 * u * u).
+		Add(b.P2.Sub(b.P1).Multiply(6 * u * t)).
+		Add(b.P3.Sub(b.P2).Multiply(3 * t * t))
+}
+
+// Split divides the curve at t into two curves that trace the same path.
+func (b CubicBezier[V]) Split(t float64) (CubicBezier[V], CubicBezier[V]) {
+	p01 := lerp(b.P0, b.P1, t)
+	p12 := lerp(b.P1, b.P2, t)
+	p23 := lerp(b.P2, b.P3, t)
+	p012 := lerp(p01, p12, t)
+	p123 := lerp(p12, p23, t)
+	mid := lerp(p012, p123, t)
+	return CubicBezier[V]{P0: b.P0, P1: p01, P2: p012, P3: mid}, CubicBezier[V]{P0: mid, P1: p123, P2: p23, P3: b.P3}
+}
+
+func lerp[V Vector[V]](a, b V, t float64) V {
+	return a.Add(b.Sub(a).Multiply(t))
+}
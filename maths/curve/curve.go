@@ -0,0 +1,127 @@
+// Package curve provides parametric curves and splines over maths.Vector2 and maths.Vector3.
+package curve
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"math"
+)
+
+// Vector is satisfied by maths.Vector2 and maths.Vector3.
+type Vector[V any] interface {
+	Add(v2 V) V
+	Sub(v2 V) V
+	Multiply(scalar float64) V
+	Dot(v2 V) float64
+	Magnitude() float64
+	Normalize() V
+	Distance(v2 V) float64
+	Distance2(v2 V) float64
+}
+
+// Curve is a path that runs from At(0) to At(1).
+type Curve[V Vector[V]] interface {
+	At(t float64) V
+	// Derivative returns the rate of change of the position with respect to t.
+	Derivative(t float64) V
+}
+
+// Tangent returns the unit direction of travel at t.
+func Tangent[V Vector[V]](c Curve[V], t float64) V {
+	return c.Derivative(t).Normalize()
+}
+
+// Normal returns the unit normal at t, pointing to the left of the direction of travel.
+func Normal(c Curve[maths.Vector2], t float64) maths.Vector2 {
+	return Tangent(c, t).Perpendicular()
+}
+
+// Frame returns an orthonormal frame at t for orienting things along a 3D curve, such as a camera on a rail.
+// The normal is the direction closest to up that is perpendicular to the tangent, which avoids the
+// sudden flips of the Frenet frame where the curve straightens out.
+func Frame(c Curve[maths.Vector3], t float64, up maths.Vector3) (tangent, normal, binormal maths.Vector3) {
+	tangent = Tangent(c, t)
+	binormal = tangent.Cross(up).Normalize()
+	if binormal == (maths.Vector3{}) {
+		// travelling straight along up, so any perpendicular will do
+		binormal = tangent.Cross(maths.Vector3{X: 1}).Normalize()
+		if binormal == (maths.Vector3{}) {
+			binormal = tangent.Cross(maths.Vector3{Y: 1}).Normalize()
+		}
+	}
+	normal = binormal.Cross(tangent)
+	return tangent, normal, binormal
+}
+
+// ClosestPoint returns the parameter and position of the point on the curve closest to v.
+// The curve is sampled at the given number of even steps to find the nearest region, which is then refined.
+func ClosestPoint[V Vector[V]](c Curve[V], v V, samples int) (float64, V) {
+	if samples < 1 {
+		samples = 1
+	}
+	best, bestDist := 0.0, math.Inf(1)
+	for i := 0; i <= samples; i++ {
+		t := float64(i) / float64(samples)
+		if d := c.At(t).Distance2(v); d < bestDist {
+			best, bestDist = t, d
+		}
+	}
+
+	// golden section search in the steps either side of the best sample
+	const ratio = 0.6180339887498949
+	lo := math.Max(0, best-1/float64(samples))
+	hi := math.Min(1, best+1/float64(samples))
+	a, b := hi-ratio*(hi-lo), lo+ratio*(hi-lo)
+	da, db := c.At(a).Distance2(v), c.At(b).Distance2(v)
+	for i := 0; i < 60; i++ {
+		if da < db {
+			hi, b, db = b, a, da
+			a = hi - ratio*(hi-lo)
+			da = c.At(a).Distance2(v)
+		} else {
+			lo, a, da = a, b, db
+			b = lo + ratio*(hi-lo)
+			db = c.At(b).Distance2(v)
+		}
+	}
+
+	t := (lo + hi) / 2
+	if c.At(t).Distance2(v) > bestDist {
+		t = best
+	}
+	return t, c.At(t)
+}
+
+// Polyline appends points along the curve to points, adding more where it bends so that no part of
+// the curve strays further than tolerance from the line. Both ends are included.
+func Polyline[V Vector[V]](points *[]V, c Curve[V], tolerance float64) {
+	*points = append(*points, c.At(0))
+	// start from a few segments so S bends whose midpoint happens to be on the chord are not missed
+	const initial = 4
+	for i := 0; i < initial; i++ {
+		t0, t1 := float64(i)/initial, float64(i+1)/initial
+		subdivide(points, c, t0, t1, c.At(t0), c.At(t1), tolerance, 0)
+	}
+}
+
+const maxSubdivisions = 16
+
+func subdivide[V Vector[V]](points *[]V, c Curve[V], t0, t1 float64, p0, p1 V, tolerance float64, depth int) {
+	tm := (t0 + t1) / 2
+	pm := c.At(tm)
+	if depth < maxSubdivisions && distanceToSegment(pm, p0, p1) > tolerance {
+		subdivide(points, c, t0, tm, p0, pm, tolerance, depth+1)
+		subdivide(points, c, tm, t1, pm, p1, tolerance, depth+1)
+		return
+	}
+	*points = append(*points, p1)
+}
+
+func distanceToSegment[V Vector[V]](v, a, b V) float64 {
+	ab := b.Sub(a)
+	length2 := ab.Dot(ab)
+	if length2 == 0 {
+		return v.Distance(a)
+	}
+	t := math.Max(0, math.Min(1, v.Sub(a).Dot(ab)/length2))
+	return v.Distance(a.Add(ab.Multiply(t)))
+}
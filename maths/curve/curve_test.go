@@ -0,0 +1,164 @@
+package curve_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/maths/curve"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func assertVector2InDelta(t *testing.T, expected, actual maths.Vector2, delta float64) {
+	t.Helper()
+	assert.True(t, expected.ApproxEqual(actual, delta), "expected %v, got %v", expected, actual)
+}
+
+// numericDerivative checks Derivative against a central difference of At.
+func assertDerivative(t *testing.T, c curve.Curve[maths.Vector2]) {
+	t.Helper()
+	const h = 1e-6
+	for _, u := range []float64{0.1, 0.3, 0.55, 0.8} {
+		numeric := c.At(u + h).Sub(c.At(u - h)).Multiply(1 / (2 * h))
+		assertVector2InDelta(t, numeric, c.Derivative(u), 1e-4)
+	}
+}
+
+func TestCurves(t *testing.T) {
+	points := []maths.Vector2{{X: 0, Y: 0}, {X: 1, Y: 2}, {X: 3, Y: 3}, {X: 4, Y: 0}, {X: 6, Y: 1}}
+
+	cases := map[string]struct {
+		curve      curve.Curve[maths.Vector2]
+		start, end maths.Vector2
+	}{
+		"quadratic bezier": {
+			curve: curve.QuadraticBezier[maths.Vector2]{P0: points[0], P1: points[1], P2: points[2]},
+			start: points[0], end: points[2],
+		},
+		"cubic bezier": {
+			curve: curve.CubicBezier[maths.Vector2]{P0: points[0], P1: points[1], P2: points[2], P3: points[3]},
+			start: points[0], end: points[3],
+		},
+		"hermite": {
+			curve: curve.Hermite[maths.Vector2]{P0: points[0], M0: maths.Vector2{X: 5}, P1: points[3], M1: maths.Vector2{Y: 5}},
+			start: points[0], end: points[3],
+		},
+		"catmull-rom": {
+			curve: curve.CatmullRom[maths.Vector2]{Points: points},
+			start: points[0], end: points[4],
+		},
+		"closed catmull-rom": {
+			curve: curve.CatmullRom[maths.Vector2]{Points: points, Closed: true},
+			start: points[0], end: points[0],
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assertVector2InDelta(t, c.start, c.curve.At(0), 1e-9)
+			assertVector2InDelta(t, c.end, c.curve.At(1), 1e-9)
+			assertDerivative(t, c.curve)
+		})
+	}
+
+	t.Run("b-spline", func(t *testing.T) {
+		b := curve.BSpline[maths.Vector2]{Points: points}
+		// starts at the weighted average of the first three points
+		assertVector2InDelta(t, points[0].Add(points[1].Multiply(4)).Add(points[2]).Multiply(1.0/6), b.At(0), 1e-9)
+		assertDerivative(t, b)
+		assertDerivative(t, curve.BSpline[maths.Vector2]{Points: points, Closed: true})
+	})
+}
+
+func TestCatmullRom_PassesThroughPoints(t *testing.T) {
+	points := []maths.Vector2{{X: 0, Y: 0}, {X: 1, Y: 2}, {X: 3, Y: 3}, {X: 4, Y: 0}}
+	c := curve.CatmullRom[maths.Vector2]{Points: points}
+	for i, p := range points {
+		assertVector2InDelta(t, p, c.At(float64(i)/3), 1e-9)
+	}
+
+	empty := curve.CatmullRom[maths.Vector2]{}
+	assert.Equal(t, maths.Vector2{}, empty.At(0.5))
+	assert.Equal(t, maths.Vector2{}, empty.Derivative(0.5))
+}
+
+func TestSplit(t *testing.T) {
+	b := curve.CubicBezier[maths.Vector2]{P0: maths.Vector2{}, P1: maths.Vector2{X: 1, Y: 3}, P2: maths.Vector2{X: 4, Y: 3}, P3: maths.Vector2{X: 5}}
+	left, right := b.Split(0.25)
+	for _, u := range []float64{0, 0.5, 1} {
+		assertVector2InDelta(t, b.At(u*0.25), left.At(u), 1e-9)
+		assertVector2InDelta(t, b.At(0.25+u*0.75), right.At(u), 1e-9)
+	}
+
+	q := curve.QuadraticBezier[maths.Vector2]{P0: maths.Vector2{}, P1: maths.Vector2{X: 1, Y: 2}, P2: maths.Vector2{X: 2}}
+	ql, qr := q.Split(0.5)
+	assertVector2InDelta(t, q.At(0.25), ql.At(0.5), 1e-9)
+	assertVector2InDelta(t, q.At(0.75), qr.At(0.5), 1e-9)
+}
+
+func TestArcLength(t *testing.T) {
+	// a quarter circle approximated by a cubic bezier
+	k := 4 * (math.Sqrt2 - 1) / 3
+	b := curve.CubicBezier[maths.Vector2]{P0: maths.Vector2{X: 1}, P1: maths.Vector2{X: 1, Y: k}, P2: maths.Vector2{X: k, Y: 1}, P3: maths.Vector2{Y: 1}}
+	arc := curve.NewArcLength[maths.Vector2](b, 256)
+	assert.InDelta(t, math.Pi/2, arc.Length(), 1e-3)
+
+	// equal distances cover equal angles
+	for _, fraction := range []float64{0.25, 0.5, 0.75} {
+		p := arc.At(arc.Length() * fraction)
+		assert.InDelta(t, math.Pi/2*fraction, p.Angle(), 1e-3)
+	}
+	assert.Equal(t, 0.0, arc.Parameter(-1))
+	assert.Equal(t, 1.0, arc.Parameter(10))
+}
+
+func TestTangentAndNormal(t *testing.T) {
+	line := curve.QuadraticBezier[maths.Vector2]{P0: maths.Vector2{}, P1: maths.Vector2{X: 1}, P2: maths.Vector2{X: 2}}
+	assertVector2InDelta(t, maths.Vector2{X: 1}, curve.Tangent[maths.Vector2](line, 0.5), 1e-9)
+	assertVector2InDelta(t, maths.Vector2{Y: 1}, curve.Normal(line, 0.5), 1e-9)
+
+	rail := curve.QuadraticBezier[maths.Vector3]{P0: maths.Vector3{}, P1: maths.Vector3{Z: 1}, P2: maths.Vector3{Z: 2}}
+	tangent, normal, binormal := curve.Frame(rail, 0.5, maths.Vector3{Y: 1})
+	assert.Equal(t, maths.Vector3{Z: 1}, tangent)
+	assert.Equal(t, maths.Vector3{Y: 1}, normal)
+	assert.InDelta(t, 0, tangent.Dot(binormal), 1e-9)
+
+	_, normal, _ = curve.Frame(rail, 0.5, maths.Vector3{Z: 1})
+	assert.InDelta(t, 1, normal.Magnitude(), 1e-9, "travelling along up")
+}
+
+func TestClosestPoint(t *testing.T) {
+	b := curve.CubicBezier[maths.Vector2]{P0: maths.Vector2{}, P1: maths.Vector2{X: 1, Y: 3}, P2: maths.Vector2{X: 4, Y: 3}, P3: maths.Vector2{X: 5}}
+	target := b.At(0.37).Add(curve.Normal(b, 0.37).Multiply(0.2))
+
+	u, p := curve.ClosestPoint[maths.Vector2](b, target, 16)
+	assert.InDelta(t, 0.37, u, 1e-6)
+	assertVector2InDelta(t, b.At(0.37), p, 1e-6)
+
+	u, _ = curve.ClosestPoint[maths.Vector2](b, maths.Vector2{X: -5, Y: -5}, 16)
+	assert.Equal(t, 0.0, u)
+}
+
+func TestPolyline(t *testing.T) {
+	b := curve.CubicBezier[maths.Vector2]{P0: maths.Vector2{}, P1: maths.Vector2{X: 1, Y: 3}, P2: maths.Vector2{X: 4, Y: 3}, P3: maths.Vector2{X: 5}}
+
+	var coarse, fine []maths.Vector2
+	curve.Polyline[maths.Vector2](&coarse, b, 0.1)
+	curve.Polyline[maths.Vector2](&fine, b, 0.001)
+	assert.Less(t, len(coarse), len(fine))
+	assert.Equal(t, b.At(0), fine[0])
+	assert.Equal(t, b.At(1), fine[len(fine)-1])
+
+	// every point on the curve is close to the polyline
+	for i := 0; i <= 100; i++ {
+		p := b.At(float64(i) / 100)
+		nearest := math.Inf(1)
+		for j := 1; j < len(coarse); j++ {
+			nearest = math.Min(nearest, maths.Segment{A: coarse[j-1], B: coarse[j]}.ClosestPoint(p).Distance(p))
+		}
+		assert.LessOrEqual(t, nearest, 0.1+1e-9)
+	}
+
+	var straight []maths.Vector2
+	curve.Polyline[maths.Vector2](&straight, curve.QuadraticBezier[maths.Vector2]{P0: maths.Vector2{}, P1: maths.Vector2{X: 1}, P2: maths.Vector2{X: 2}}, 0.01)
+	assert.Len(t, straight, 5)
+}
@@ -0,0 +1,160 @@
+package curve
+
+import "math"
+
+// Hermite is a curve from P0 to P1 that leaves with velocity M0 and arrives with velocity M1.
+type Hermite[V Vector[V]] struct {
+	P0, M0, P1, M1 V
+}
+
+func (h Hermite[V]) At(t float64) V {
+	t2, t3 := t*t, t*t*t
+	return h.P0.Multiply(2*t3 - 3*t2 + 1).
+		Add(h.M0.Multiply(t3 - 2*t2 + t)).
+		Add(h.P1.Multiply(-2*t3 + 3*t2)).
+		Add(h.M1.Multiply(t3 - t2))
+}
+
+func (h Hermite[V]) Derivative(t float64) V {
+	t2 := t * t
+	return h.P0.Multiply(6*t2 - 6*t).
+		Add(h.M0.Multiply(3*t2 - 4*t + 1)).
+		Add(h.P1.Multiply(-6*t2 + 6*t)).
+		Add(h.M1.Multiply(3*t2 - 2*t))
+}
+
+// CatmullRom is a spline that passes through every point.
+// The whole spline runs from t 0 to 1, with each span between points taking an equal share.
+// An open spline needs at least two points; its end spans mirror the neighbouring point.
+type CatmullRom[V Vector[V]] struct {
+	Points []V
+	Closed bool
+}
+
+func (c CatmullRom[V]) At(t float64) V {
+	segment, local, ok := c.segment(t)
+	if !ok {
+		var zero V
+		return zero
+	}
+	return segment.At(local)
+}
+
+func (c CatmullRom[V]) Derivative(t float64) V {
+	segment, local, ok := c.segment(t)
+	if !ok {
+		var zero V
+		return zero
+	}
+	return segment.Derivative(local).Multiply(float64(c.spans()))
+}
+
+func (c CatmullRom[V]) spans() int {
+	if c.Closed {
+		return len(c.Points)
+	}
+	return len(c.Points) - 1
+}
+
+// segment returns the span containing t as a Hermite curve, and t within that span.
+func (c CatmullRom[V]) segment(t float64) (Hermite[V], float64, bool) {
+	spans := c.spans()
+	if len(c.Points) < 2 || spans < 1 {
+		return Hermite[V]{}, 0, false
+	}
+	i, local := spanAt(t, spans)
+
+	p1, p2 := c.point(i), c.point(i+1)
+	p0, p3 := c.point(i-1), c.point(i+2)
+	if !c.Closed {
+		// mirror the neighbours past the ends
+		if i == 0 {
+			p0 = p1.Multiply(2).Sub(p2)
+		}
+		if i+1 == len(c.Points)-1 {
+			p3 = p2.Multiply(2).Sub(p1)
+		}
+	}
+	return Hermite[V]{P0: p1, M0: p2.Sub(p0).Multiply(0.5), P1: p2, M1: p3.Sub(p1).Multiply(0.5)}, local, true
+}
+
+func (c CatmullRom[V]) point(i int) V {
+	n := len(c.Points)
+	if c.Closed {
+		return c.Points[((i%n)+n)%n]
+	}
+	if i < 0 {
+		return c.Points[0]
+	}
+	if i >= n {
+		return c.Points[n-1]
+	}
+	return c.Points[i]
+}
+
+// BSpline is a uniform cubic B-spline. It is smoother than a CatmullRom but only passes near the
+// control points, not through them. An open spline needs at least four points.
+type BSpline[V Vector[V]] struct {
+	Points []V
+	Closed bool
+}
+
+func (b BSpline[V]) At(t float64) V {
+	p, local, ok := b.segment(t)
+	if !ok {
+		var zero V
+		return zero
+	}
+	u := local
+	u2, u3 := u*u, u*u*u
+	return p[0].Multiply((1 - 3*u + 3*u2 - u3) / 6).
+		Add(p[1].Multiply((4 - 6*u2 + 3*u3) / 6)).
+		Add(p[2].Multiply((1 + 3*u + 3*u2 - 3*u3) / 6)).
+		Add(p[3].Multiply(u3 / 6))
+}
+
+func (b BSpline[V]) Derivative(t float64) V {
+	p, local, ok := b.segment(t)
+	if !ok {
+		var zero V
+		return zero
+	}
+	u := local
+	u2 := u * u
+	return p[0].Multiply((-3 + 6*u - 3*u2) / 6).
+		Add(p[1].Multiply((-12*u + 9*u2) / 6)).
+		Add(p[2].Multiply((3 + 6*u - 9*u2) / 6)).
+		Add(p[3].Multiply(3 * u2 / 6)).
+		Multiply(float64(b.spans()))
+}
+
+func (b BSpline[V]) spans() int {
+	if b.Closed {
+		return len(b.Points)
+	}
+	return len(b.Points) - 3
+}
+
+func (b BSpline[V]) segment(t float64) ([4]V, float64, bool) {
+	var p [4]V
+	spans := b.spans()
+	if spans < 1 || len(b.Points) < 3 {
+		return p, 0, false
+	}
+	i, local := spanAt(t, spans)
+	n := len(b.Points)
+	for k := range p {
+		p[k] = b.Points[(i+k)%n]
+	}
+	return p, local, true
+}
+
+// spanAt maps t in [0, 1] onto one of the spans and the position within it.
+func spanAt(t float64, spans int) (int, float64) {
+	scaled := math.Max(0, math.Min(1, t)) * float64(spans)
+	i := int(scaled)
+	if i == spans {
+		i--
+	}
+	return i, scaled - float64(i)
+}
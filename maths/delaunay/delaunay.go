@@ -0,0 +1,231 @@
+// Package delaunay builds Delaunay triangulations of points and the Voronoi diagrams they are dual to.
+package delaunay
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/utils"
+)
+
+// Triangulation is a Delaunay triangulation: no point lies inside the circumcircle of any triangle.
+type Triangulation struct {
+	Points []maths.Vector2
+	// Triangles index into Points and wind counter-clockwise.
+	Triangles [][3]int
+}
+
+// superDirections point from the input towards the three vertices of the super triangle. The
+// vertices are symbolic: they sit infinitely far away in these directions, so the super triangle
+// contains every point and never lies inside the circumcircle of a triangle of real points. The
+// directions share a length and wind counter-clockwise.
+var superDirections = [3]maths.Vector2{{X: 20, Y: 15}, {X: -15, Y: 20}, {X: -7, Y: -24}}
+
+// Triangulate returns the Delaunay triangulation of points using the Bowyer-Watson algorithm.
+// Duplicate points are left out of every triangle, and collinear points produce no triangles.
+// Cocircular points are split into triangles arbitrarily but consistently.
+func Triangulate(points []maths.Vector2) Triangulation {
+	t := Triangulation{Points: points}
+	if len(points) < 3 {
+		return t
+	}
+
+	n := len(points)
+	m := mesh{points: points, edges: map[[2]int]int{}}
+	m.add([3]int{n, n + 1, n + 2})
+
+	seen := make(map[maths.Vector2]bool, n)
+	var cavity []int
+	var boundary [][2]int
+	for i, p := range points {
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		stamp := i + 1
+
+		// grow the cavity outwards from the triangle containing the point through every neighbor
+		// whose circumcircle contains it
+		first := m.locate(p)
+		m.mark[first] = stamp
+		cavity = append(cavity[:0], first)
+		for k := 0; k < len(cavity); k++ {
+			tri := m.triangles[cavity[k]]
+			for e := 0; e < 3; e++ {
+				next, ok := m.edges[[2]int{tri[(e+1)%3], tri[e]}]
+				if ok && m.mark[next] != stamp && m.inCircumcircle(m.triangles[next], p) {
+					m.mark[next] = stamp
+					cavity = append(cavity, next)
+				}
+			}
+		}
+
+		// the point must see every boundary edge of the cavity from the inside, so take in the
+		// triangle behind any edge it does not
+		for grown := true; grown; {
+			grown = false
+			boundary = boundary[:0]
+			for _, k := range cavity {
+				tri := m.triangles[k]
+				for e := 0; e < 3; e++ {
+					a, b := tri[e], tri[(e+1)%3]
+					next, ok := m.edges[[2]int{b, a}]
+					if ok && m.mark[next] == stamp {
+						continue
+					}
+					if m.orient(a, b, p) > 0 {
+						boundary = append(boundary, [2]int{a, b})
+					} else if ok {
+						m.mark[next] = stamp
+						cavity = append(cavity, next)
+						grown = true
+					}
+				}
+			}
+		}
+
+		// then fan new triangles from the point to the edges of the cavity
+		for _, k := range cavity {
+			m.remove(k)
+		}
+		for _, e := range boundary {
+			m.add([3]int{e[0], e[1], i})
+		}
+	}
+
+	for k, tri := range m.triangles {
+		if m.alive[k] && tri[0] < n && tri[1] < n && tri[2] < n {
+			t.Triangles = append(t.Triangles, tri)
+		}
+	}
+	return t
+}
+
+// mesh is the triangulation under construction. Vertices from len(points) up are the symbolic
+// vertices of the super triangle.
+type mesh struct {
+	points    []maths.Vector2
+	triangles [][3]int
+	alive     []bool
+	mark      []int
+	free      []int
+	// edges maps each directed edge to the triangle it winds counter-clockwise around
+	edges map[[2]int]int
+}
+
+func (m *mesh) add(tri [3]int) {
+	k := len(m.triangles)
+	if len(m.free) > 0 {
+		k = m.free[len(m.free)-1]
+		m.free = m.free[:len(m.free)-1]
+		m.triangles[k] = tri
+		m.alive[k] = true
+	} else {
+		m.triangles = append(m.triangles, tri)
+		m.alive = append(m.alive, true)
+		m.mark = append(m.mark, 0)
+	}
+	for e := 0; e < 3; e++ {
+		m.edges[[2]int{tri[e], tri[(e+1)%3]}] = k
+	}
+}
+
+func (m *mesh) remove(k int) {
+	tri := m.triangles[k]
+	for e := 0; e < 3; e++ {
+		delete(m.edges, [2]int{tri[e], tri[(e+1)%3]})
+	}
+	m.alive[k] = false
+	m.free = append(m.free, k)
+}
+
+// locate returns a triangle containing p, which may lie on its edges.
+func (m *mesh) locate(p maths.Vector2) int {
+	for k, tri := range m.triangles {
+		if m.alive[k] && m.orient(tri[0], tri[1], p) >= 0 && m.orient(tri[1], tri[2], p) >= 0 && m.orient(tri[2], tri[0], p) >= 0 {
+			return k
+		}
+	}
+	// unreachable: the super triangle covers the plane
+	panic("delaunay: point outside the super triangle")
+}
+
+// orient returns the orientation of the vertices a and b with the point p.
+func (m *mesh) orient(a, b int, p maths.Vector2) int {
+	n := len(m.points)
+	switch {
+	case a < n && b < n:
+		return orient(m.points[a], m.points[b], p)
+	case a < n:
+		return side(superDirections[b-n], m.points[a], p)
+	case b < n:
+		return -side(superDirections[a-n], m.points[b], p)
+	}
+	u, v := superDirections[a-n], superDirections[b-n]
+	return sign(u.X*v.Y - u.Y*v.X)
+}
+
+// inCircumcircle reports whether p is strictly inside the circumcircle of the counter-clockwise triangle tri.
+func (m *mesh) inCircumcircle(tri [3]int, p maths.Vector2) bool {
+	n := len(m.points)
+	supers := 0
+	for _, v := range tri {
+		if v >= n {
+			supers++
+		}
+	}
+
+	switch supers {
+	case 0:
+		return inCircle(m.points[tri[0]], m.points[tri[1]], m.points[tri[2]], p) > 0
+	case 1:
+		// the circumcircle becomes the half plane to the left of the real edge ab
+		for tri[2] < n {
+			tri = [3]int{tri[1], tri[2], tri[0]}
+		}
+		a, b := m.points[tri[0]], m.points[tri[1]]
+		if o := orient(a, b, p); o != 0 {
+			return o > 0
+		}
+		if a.X != b.X {
+			return between(a.X, p.X, b.X)
+		}
+		return between(a.Y, p.Y, b.Y)
+	case 2:
+		// the circumcircle becomes the half plane through a facing away from the far edge
+		for tri[0] >= n {
+			tri = [3]int{tri[1], tri[2], tri[0]}
+		}
+		d := superDirections[tri[2]-n].Sub(superDirections[tri[1]-n])
+		return side(d, m.points[tri[0]], p) < 0
+	}
+	return true
+}
+
+// Triangle returns the triangle at index i.
+func (t Triangulation) Triangle(i int) maths.Triangle {
+	tri := t.Triangles[i]
+	return maths.Triangle{A: t.Points[tri[0]], B: t.Points[tri[1]], C: t.Points[tri[2]]}
+}
+
+// Neighbors returns, for each point, the points it shares a triangle edge with.
+func (t Triangulation) Neighbors() [][]int {
+	neighbors := make([][]int, len(t.Points))
+	for _, tri := range t.Triangles {
+		for e := 0; e < 3; e++ {
+			a, b := tri[e], tri[(e+1)%3]
+			// each interior edge is seen from both sides, once in each direction
+			if _, found := utils.Find(neighbors[a], b); !found {
+				neighbors[a] = append(neighbors[a], b)
+				neighbors[b] = append(neighbors[b], a)
+			}
+		}
+	}
+	return neighbors
+}
+
+// between reports whether v is strictly between a and b.
+func between(a, v, b float64) bool {
+	if a > b {
+		a, b = b, a
+	}
+	return a < v && v < b
+}
@@ -0,0 +1,219 @@
+package delaunay_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/maths/delaunay"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomPoints(seed int64, n int, bounds maths.Rectangle) []maths.Vector2 {
+	r := rand.New(rand.NewSource(seed))
+	points := make([]maths.Vector2, n)
+	for i := range points {
+		points[i] = maths.Vector2{X: bounds.X + r.Float64()*bounds.Width, Y: bounds.Y + r.Float64()*bounds.Height}
+	}
+	return points
+}
+
+func TestTriangulate(t *testing.T) {
+	points := randomPoints(1, 300, maths.Rectangle{Width: 100, Height: 100})
+	tri := delaunay.Triangulate(points)
+
+	// a triangulation of n points with h on the hull has 2n - 2 - h triangles covering the hull
+	hull := maths.ConvexHull(points)
+	assert.Len(t, tri.Triangles, 2*len(points)-2-len(hull.Vertices))
+
+	var area float64
+	for i := range tri.Triangles {
+		triangle := tri.Triangle(i)
+		assert.Greater(t, triangle.ToPolygon().SignedArea(), 0.0)
+		area += triangle.Area()
+
+		// no point is inside a circumcircle
+		center, radius := circumcircle(triangle)
+		for _, p := range points {
+			assert.GreaterOrEqual(t, p.Distance(center), radius-1e-7)
+		}
+	}
+	assert.InDelta(t, hull.Area(), area, 1e-6)
+
+	neighbors := tri.Neighbors()
+	for i := range neighbors {
+		for _, j := range neighbors[i] {
+			assert.Contains(t, neighbors[j], i)
+		}
+	}
+}
+
+func TestTriangulate_Seeds(t *testing.T) {
+	for seed := int64(1); seed <= 200; seed++ {
+		points := randomPoints(seed, 3+int(seed%60), maths.Rectangle{X: -20, Y: 10, Width: 40, Height: 25})
+		tri := delaunay.Triangulate(points)
+
+		hull := maths.ConvexHull(points)
+		assert.Len(t, tri.Triangles, 2*len(points)-2-len(hull.Vertices), "seed %d", seed)
+		var area float64
+		for i := range tri.Triangles {
+			area += tri.Triangle(i).Area()
+		}
+		assert.InDelta(t, hull.Area(), area, 1e-9, "seed %d", seed)
+	}
+}
+
+// assertFan checks that points in convex position are split into n - 2 counter-clockwise
+// triangles covering their hull.
+func assertFan(t *testing.T, points []maths.Vector2) {
+	t.Helper()
+	tri := delaunay.Triangulate(points)
+	assert.Len(t, tri.Triangles, len(points)-2)
+
+	var area float64
+	for i := range tri.Triangles {
+		triangle := tri.Triangle(i)
+		assert.Greater(t, triangle.ToPolygon().SignedArea(), 0.0)
+		area += triangle.Area()
+	}
+	assert.InDelta(t, maths.ConvexHull(points).Area(), area, 1e-9)
+}
+
+func TestTriangulate_Degenerate(t *testing.T) {
+	square := []maths.Vector2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}, {X: 1, Y: 1}, {X: 0.5, Y: 0.5}}
+	tri := delaunay.Triangulate(square)
+	assert.Len(t, tri.Triangles, 4)
+	for _, triangle := range tri.Triangles {
+		assert.NotContains(t, triangle, 4)
+	}
+
+	// every point on the same circle, exactly for the integer points and to rounding for the rest
+	exact := []maths.Vector2{
+		{X: 5, Y: 0}, {X: 4, Y: 3}, {X: 3, Y: 4}, {X: 0, Y: 5}, {X: -3, Y: 4}, {X: -4, Y: 3},
+		{X: -5, Y: 0}, {X: -4, Y: -3}, {X: -3, Y: -4}, {X: 0, Y: -5}, {X: 3, Y: -4}, {X: 4, Y: -3},
+	}
+	assertFan(t, exact)
+
+	polygon := make([]maths.Vector2, 12)
+	for i := range polygon {
+		angle := 2 * math.Pi * float64(i) / float64(len(polygon))
+		polygon[i] = maths.Vector2{X: 10 * math.Cos(angle), Y: 10 * math.Sin(angle)}
+	}
+	assertFan(t, polygon)
+
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{6, 40} {
+		circle := make([]maths.Vector2, n)
+		for i := range circle {
+			angle := 2 * math.Pi * r.Float64()
+			circle[i] = maths.Vector2{X: 3 + 10*math.Cos(angle), Y: -2 + 10*math.Sin(angle)}
+		}
+		assertFan(t, circle)
+	}
+
+	line := []maths.Vector2{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}}
+	assert.Empty(t, delaunay.Triangulate(line).Triangles)
+	assert.Empty(t, delaunay.Triangulate(line[:2]).Triangles)
+}
+
+func TestVoronoi(t *testing.T) {
+	bounds := maths.Rectangle{X: -50, Y: -20, Width: 100, Height: 60}
+	sites := randomPoints(2, 200, bounds)
+	v := delaunay.NewVoronoi(sites, bounds)
+
+	var area float64
+	for i, cell := range v.Cells {
+		assert.True(t, cell.Polygon.ContainsVec(sites[i]))
+		assert.Greater(t, cell.Polygon.SignedArea(), 0.0)
+		assert.Len(t, cell.Neighbors, len(cell.Polygon.Vertices))
+		area += cell.Polygon.Area()
+	}
+	assert.InDelta(t, bounds.Width*bounds.Height, area, 1e-6)
+
+	// any point belongs to the cell of its nearest site
+	for _, p := range randomPoints(3, 200, bounds) {
+		nearest := 0
+		for i := range sites {
+			if sites[i].Distance2(p) < sites[nearest].Distance2(p) {
+				nearest = i
+			}
+		}
+		assert.True(t, v.Cells[nearest].Polygon.ContainsVec(p))
+	}
+
+	graph := v.Graph()
+	assert.Equal(t, len(sites), graph.Len())
+	for i, cell := range v.Cells {
+		for _, j := range graph.Get(i).Adjacent {
+			assert.Contains(t, cell.Neighbors, j)
+		}
+	}
+}
+
+func TestVoronoi_Grid(t *testing.T) {
+	var sites []maths.Vector2
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 3; x++ {
+			sites = append(sites, maths.Vector2{X: float64(x) + 0.5, Y: float64(y) + 0.5})
+		}
+	}
+	v := delaunay.NewVoronoi(sites, maths.Rectangle{Width: 3, Height: 3})
+	for _, cell := range v.Cells {
+		assert.InDelta(t, 1, cell.Polygon.Area(), 1e-9)
+	}
+
+	// diagonal cells only touch at a corner
+	graph := v.Graph()
+	assert.ElementsMatch(t, []int{1, 3, 5, 7}, graph.Get(4).Adjacent)
+	assert.ElementsMatch(t, []int{1, 3}, graph.Get(0).Adjacent)
+}
+
+func TestVoronoi_Degenerate(t *testing.T) {
+	bounds := maths.Rectangle{Width: 10, Height: 10}
+
+	v := delaunay.NewVoronoi([]maths.Vector2{{X: 1, Y: 5}, {X: 5, Y: 5}, {X: 9, Y: 5}, {X: 5, Y: 5}}, bounds)
+	assert.InDelta(t, 30, v.Cells[0].Polygon.Area(), 1e-9)
+	assert.InDelta(t, 40, v.Cells[1].Polygon.Area(), 1e-9)
+	assert.InDelta(t, 30, v.Cells[2].Polygon.Area(), 1e-9)
+	assert.Empty(t, v.Cells[3].Polygon.Vertices)
+	assert.ElementsMatch(t, []int{0, 2}, v.Graph().Get(1).Adjacent)
+
+	v = delaunay.NewVoronoi([]maths.Vector2{{X: 3, Y: 3}}, bounds)
+	assert.InDelta(t, 100, v.Cells[0].Polygon.Area(), 1e-9)
+}
+
+func TestRelax(t *testing.T) {
+	bounds := maths.Rectangle{Width: 100, Height: 100}
+	sites := randomPoints(4, 100, bounds)
+
+	spread := func(sites []maths.Vector2) float64 {
+		v := delaunay.NewVoronoi(sites, bounds)
+		var sum, sum2 float64
+		for _, cell := range v.Cells {
+			a := cell.Polygon.Area()
+			sum += a
+			sum2 += a * a
+		}
+		n := float64(len(v.Cells))
+		return math.Sqrt(sum2/n - (sum/n)*(sum/n))
+	}
+
+	relaxed := delaunay.Relax(sites, bounds, 10)
+	assert.Len(t, relaxed, len(sites))
+	for _, p := range relaxed {
+		assert.True(t, bounds.ContainsVec(p))
+	}
+	assert.Less(t, spread(relaxed), spread(sites)/3)
+	assert.Equal(t, sites, delaunay.Relax(sites, bounds, 0))
+}
+
+func circumcircle(t maths.Triangle) (maths.Vector2, float64) {
+	b, c := t.B.Sub(t.A), t.C.Sub(t.A)
+	d := 2 * b.Cross(c)
+	center := maths.Vector2{
+		X: (c.Y*b.Magnitude2() - b.Y*c.Magnitude2()) / d,
+		Y: (b.X*c.Magnitude2() - c.X*b.Magnitude2()) / d,
+	}
+	return t.A.Add(center), center.Magnitude()
+}
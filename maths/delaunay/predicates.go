@@ -0,0 +1,109 @@
+package delaunay
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/soupstoregames/gamelib/maths"
+)
+
+// The predicates below return exact signs. They evaluate in float64 first and only fall back to
+// exact rational arithmetic when the result is within the rounding error bound of zero, following
+// Shewchuk's "Adaptive Precision Floating-Point Arithmetic and Fast Robust Geometric Predicates".
+// Products are wrapped in float64 conversions so they are not fused into multiply-adds, which the
+// bounds do not account for.
+
+const epsilon = 1.0 / (1 << 53)
+
+const (
+	orientBound   = (3 + 16*epsilon) * epsilon
+	inCircleBound = (10 + 96*epsilon) * epsilon
+)
+
+// orient returns 1 if abc winds counter-clockwise, -1 if clockwise and 0 if the points are collinear.
+func orient(a, b, c maths.Vector2) int {
+	left := float64((a.X - c.X) * (b.Y - c.Y))
+	right := float64((a.Y - c.Y) * (b.X - c.X))
+	det := left - right
+	if bound := orientBound * (math.Abs(left) + math.Abs(right)); det > bound || -det > bound {
+		return sign(det)
+	}
+
+	det2 := new(big.Rat).Sub(
+		new(big.Rat).Mul(ratSub(a.X, c.X), ratSub(b.Y, c.Y)),
+		new(big.Rat).Mul(ratSub(a.Y, c.Y), ratSub(b.X, c.X)),
+	)
+	return det2.Sign()
+}
+
+// side returns the sign of the cross product of direction d with p - a, which is 1 when p is to
+// the left of the line through a along d.
+func side(d, a, p maths.Vector2) int {
+	left := float64(d.X * (p.Y - a.Y))
+	right := float64(d.Y * (p.X - a.X))
+	det := left - right
+	if bound := orientBound * (math.Abs(left) + math.Abs(right)); det > bound || -det > bound {
+		return sign(det)
+	}
+
+	det2 := new(big.Rat).Sub(
+		new(big.Rat).Mul(rat(d.X), ratSub(p.Y, a.Y)),
+		new(big.Rat).Mul(rat(d.Y), ratSub(p.X, a.X)),
+	)
+	return det2.Sign()
+}
+
+// inCircle returns 1 if d is inside the circumcircle of the counter-clockwise triangle abc,
+// -1 if it is outside and 0 if it is on the circle.
+func inCircle(a, b, c, d maths.Vector2) int {
+	adx, ady := a.X-d.X, a.Y-d.Y
+	bdx, bdy := b.X-d.X, b.Y-d.Y
+	cdx, cdy := c.X-d.X, c.Y-d.Y
+
+	bdxcdy, cdxbdy := float64(bdx*cdy), float64(cdx*bdy)
+	cdxady, adxcdy := float64(cdx*ady), float64(adx*cdy)
+	adxbdy, bdxady := float64(adx*bdy), float64(bdx*ady)
+	alift := float64(adx*adx) + float64(ady*ady)
+	blift := float64(bdx*bdx) + float64(bdy*bdy)
+	clift := float64(cdx*cdx) + float64(cdy*cdy)
+
+	det := float64(alift*(bdxcdy-cdxbdy)) + float64(blift*(cdxady-adxcdy)) + float64(clift*(adxbdy-bdxady))
+	permanent := float64((math.Abs(bdxcdy)+math.Abs(cdxbdy))*alift) +
+		float64((math.Abs(cdxady)+math.Abs(adxcdy))*blift) +
+		float64((math.Abs(adxbdy)+math.Abs(bdxady))*clift)
+	if bound := inCircleBound * permanent; det > bound || -det > bound {
+		return sign(det)
+	}
+
+	ax, ay := ratSub(a.X, d.X), ratSub(a.Y, d.Y)
+	bx, by := ratSub(b.X, d.X), ratSub(b.Y, d.Y)
+	cx, cy := ratSub(c.X, d.X), ratSub(c.Y, d.Y)
+	lift := func(x, y *big.Rat) *big.Rat {
+		return new(big.Rat).Add(new(big.Rat).Mul(x, x), new(big.Rat).Mul(y, y))
+	}
+	cross := func(x1, y1, x2, y2 *big.Rat) *big.Rat {
+		return new(big.Rat).Sub(new(big.Rat).Mul(x1, y2), new(big.Rat).Mul(x2, y1))
+	}
+	det2 := new(big.Rat).Mul(lift(ax, ay), cross(bx, by, cx, cy))
+	det2.Add(det2, new(big.Rat).Mul(lift(bx, by), cross(cx, cy, ax, ay)))
+	det2.Add(det2, new(big.Rat).Mul(lift(cx, cy), cross(ax, ay, bx, by)))
+	return det2.Sign()
+}
+
+func sign(v float64) int {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+	return 0
+}
+
+func rat(v float64) *big.Rat {
+	return new(big.Rat).SetFloat64(v)
+}
+
+func ratSub(a, b float64) *big.Rat {
+	return new(big.Rat).Sub(rat(a), rat(b))
+}
@@ -0,0 +1,142 @@
+package delaunay
+
+import (
+	"github.com/soupstoregames/gamelib/data"
+	"github.com/soupstoregames/gamelib/maths"
+)
+
+// Cell is the region of a Voronoi diagram closer to its site than to any other.
+type Cell struct {
+	// Polygon is convex and counter-clockwise. It is empty for duplicate sites and sites whose
+	// region lies outside the bounds.
+	Polygon maths.Polygon
+	// Neighbors[i] is the site across the edge from vertex i to the next, or -1 for the bounds.
+	Neighbors []int
+}
+
+// Voronoi is a Voronoi diagram clipped to a rectangle.
+type Voronoi struct {
+	Sites  []maths.Vector2
+	Bounds maths.Rectangle
+	// Cells[i] is the cell of Sites[i].
+	Cells []Cell
+}
+
+// NewVoronoi builds the Voronoi diagram of sites from their Delaunay triangulation.
+// Each cell is the bounds clipped by the bisectors between its site and its Delaunay neighbours.
+func NewVoronoi(sites []maths.Vector2, bounds maths.Rectangle) Voronoi {
+	v := Voronoi{Sites: sites, Bounds: bounds, Cells: make([]Cell, len(sites))}
+	t := Triangulate(sites)
+	neighbors := t.Neighbors()
+
+	first := make(map[maths.Vector2]int, len(sites))
+	var scratch Cell
+	for i, site := range sites {
+		if _, ok := first[site]; ok {
+			continue
+		}
+		first[site] = i
+
+		cell := Cell{
+			Polygon: maths.Polygon{Vertices: []maths.Vector2{
+				{X: bounds.X, Y: bounds.Y},
+				{X: bounds.X + bounds.Width, Y: bounds.Y},
+				{X: bounds.X + bounds.Width, Y: bounds.Y + bounds.Height},
+				{X: bounds.X, Y: bounds.Y + bounds.Height},
+			}},
+			Neighbors: []int{-1, -1, -1, -1},
+		}
+		if len(neighbors[i]) > 0 {
+			for _, j := range neighbors[i] {
+				clip(&cell, &scratch, site, sites[j], j)
+			}
+		} else {
+			// no triangles touch the site when the input is collinear, so clip by every other site
+			for j, other := range sites {
+				if other != site {
+					clip(&cell, &scratch, site, other, j)
+				}
+			}
+		}
+		if len(cell.Polygon.Vertices) >= 3 {
+			v.Cells[i] = cell
+		}
+	}
+	return v
+}
+
+// Graph returns the adjacency of cells that share an edge. Node i is the cell of Sites[i] and
+// holds i as its element.
+func (v Voronoi) Graph() *data.UndirectedGraph[int] {
+	graph := data.NewUndirectedGraph[int]()
+	for i := range v.Cells {
+		graph.Insert(i)
+	}
+	for i, cell := range v.Cells {
+		for k, j := range cell.Neighbors {
+			if j <= i {
+				continue
+			}
+			if cell.Polygon.Edge(k).Length() > maths.Epsilon {
+				graph.Connect(i, j)
+			}
+		}
+	}
+	return graph
+}
+
+// Relax moves each site to the centroid of its cell, which evens out their spacing.
+// Repeated relaxation converges towards a centroidal Voronoi diagram.
+func (v Voronoi) Relax() []maths.Vector2 {
+	sites := make([]maths.Vector2, len(v.Sites))
+	for i, cell := range v.Cells {
+		if len(cell.Polygon.Vertices) == 0 {
+			sites[i] = v.Sites[i]
+			continue
+		}
+		sites[i] = cell.Polygon.Centroid()
+	}
+	return sites
+}
+
+// Relax applies Lloyd relaxation to sites the given number of times.
+func Relax(sites []maths.Vector2, bounds maths.Rectangle, iterations int) []maths.Vector2 {
+	for i := 0; i < iterations; i++ {
+		sites = NewVoronoi(sites, bounds).Relax()
+	}
+	return sites
+}
+
+// clip removes the part of the cell closer to other than to site, tagging the new edge with j.
+func clip(cell, scratch *Cell, site, other maths.Vector2, j int) {
+	normal := other.Sub(site)
+	mid := site.Add(other).Multiply(0.5)
+	side := func(p maths.Vector2) float64 {
+		return p.Sub(mid).Dot(normal)
+	}
+
+	vertices := cell.Polygon.Vertices
+	scratch.Polygon.Vertices = scratch.Polygon.Vertices[:0]
+	scratch.Neighbors = scratch.Neighbors[:0]
+	for k, a := range vertices {
+		b := vertices[(k+1)%len(vertices)]
+		sa, sb := side(a), side(b)
+		if sa <= 0 {
+			scratch.Polygon.Vertices = append(scratch.Polygon.Vertices, a)
+			if sb <= 0 {
+				scratch.Neighbors = append(scratch.Neighbors, cell.Neighbors[k])
+				continue
+			}
+			// leaving the cell, the bisector becomes the next edge
+			scratch.Neighbors = append(scratch.Neighbors, cell.Neighbors[k])
+			scratch.Polygon.Vertices = append(scratch.Polygon.Vertices, a.Lerp(b, sa/(sa-sb)))
+			scratch.Neighbors = append(scratch.Neighbors, j)
+		} else if sb <= 0 {
+			// entering the cell part way along the edge
+			scratch.Polygon.Vertices = append(scratch.Polygon.Vertices, a.Lerp(b, sa/(sa-sb)))
+			scratch.Neighbors = append(scratch.Neighbors, cell.Neighbors[k])
+		}
+	}
+	cell.Polygon.Vertices, scratch.Polygon.Vertices = scratch.Polygon.Vertices, cell.Polygon.Vertices
+	cell.Neighbors, scratch.Neighbors = scratch.Neighbors, cell.Neighbors
+}
@@ -0,0 +1,211 @@
+package maths
+
+import (
+	"math"
+	"math/rand"
+)
+
+// EnclosingCircle returns the smallest circle containing every point, using Welzl's algorithm.
+func EnclosingCircle(points []Vector2) Circle {
+	balls := make([]Sphere, len(points))
+	for i, p := range points {
+		balls[i] = Sphere{Center: Vector3{X: p.X, Y: p.Y}}
+	}
+	s := encloseBalls(balls, 3)
+	return Circle{Center: Vector2{X: s.Center.X, Y: s.Center.Y}, Radius: s.Radius}
+}
+
+// EnclosingCircleOfCircles returns the smallest circle containing every circle.
+func EnclosingCircleOfCircles(circles []Circle) Circle {
+	balls := make([]Sphere, len(circles))
+	for i, c := range circles {
+		balls[i] = Sphere{Center: Vector3{X: c.Center.X, Y: c.Center.Y}, Radius: c.Radius}
+	}
+	s := encloseBalls(balls, 3)
+	return Circle{Center: Vector2{X: s.Center.X, Y: s.Center.Y}, Radius: s.Radius}
+}
+
+// EnclosingSphere returns the smallest sphere containing every point, using Welzl's algorithm.
+func EnclosingSphere(points []Vector3) Sphere {
+	balls := make([]Sphere, len(points))
+	for i, p := range points {
+		balls[i] = Sphere{Center: p}
+	}
+	return encloseBalls(balls, 4)
+}
+
+// EnclosingSphereOfSpheres returns the smallest sphere containing every sphere.
+func EnclosingSphereOfSpheres(spheres []Sphere) Sphere {
+	balls := make([]Sphere, len(spheres))
+	copy(balls, spheres)
+	return encloseBalls(balls, 4)
+}
+
+// encloseBalls runs Welzl's algorithm over balls, which it shuffles in place.
+// A minimal ball is fixed by at most maxSupport of the balls touching its surface: 3 in 2D and 4 in 3D.
+// 2D problems are solved as 3D ones with every ball at Z 0.
+func encloseBalls(balls []Sphere, maxSupport int) Sphere {
+	if len(balls) == 0 {
+		return Sphere{}
+	}
+	// the expected linear running time relies on a random order, seeded so results are repeatable
+	r := rand.New(rand.NewSource(int64(len(balls))))
+	r.Shuffle(len(balls), func(i, j int) {
+		balls[i], balls[j] = balls[j], balls[i]
+	})
+
+	var support [4]Sphere
+	return welzl(balls, support[:0], maxSupport)
+}
+
+// welzl returns the smallest ball containing balls with every ball in support touching its surface.
+func welzl(balls []Sphere, support []Sphere, maxSupport int) Sphere {
+	enclosing := ballFromSupport(support)
+	if len(support) == maxSupport {
+		return enclosing
+	}
+	for i, b := range balls {
+		if !ballContains(enclosing, b) {
+			enclosing = welzl(balls[:i], append(support, b), maxSupport)
+		}
+	}
+	return enclosing
+}
+
+func ballContains(s, b Sphere) bool {
+	return s.Center.Distance(b.Center)+b.Radius <= s.Radius+1e-9*math.Max(1, s.Radius)
+}
+
+// ballFromSupport returns the smallest ball that every support ball touches from the inside.
+// The centre lies in the affine hull of the support centres, c0 + sum(l_j * e_j) with e_j = c_j - c0.
+// Each |p - c_i| = r - r_i gives a linear equation in l once the first is subtracted, so l is
+// solved as a linear function of r, and the first equation becomes a quadratic in r.
+func ballFromSupport(support []Sphere) Sphere {
+	switch len(support) {
+	case 0:
+		// contains nothing, so the first ball always becomes support
+		return Sphere{Radius: -1}
+	case 1:
+		return support[0]
+	}
+
+	c0, r0 := support[0].Center, support[0].Radius
+	k := len(support) - 1
+	var e [3]Vector3
+	var g [3][3]float64
+	var alpha, beta [3]float64
+	for i := 0; i < k; i++ {
+		e[i] = support[i+1].Center.Sub(c0)
+	}
+	for i := 0; i < k; i++ {
+		ri := support[i+1].Radius
+		for j := 0; j < k; j++ {
+			g[i][j] = 2 * e[i].Dot(e[j])
+		}
+		alpha[i] = e[i].Dot(e[i]) - ri*ri + r0*r0
+		beta[i] = 2 * (ri - r0)
+	}
+
+	la, okA := solveLinear(g, alpha, k)
+	lb, okB := solveLinear(g, beta, k)
+	if !okA || !okB {
+		// degenerate support, such as collinear centres, is covered by its largest pair
+		return degenerateSupport(support)
+	}
+
+	var a, b Vector3
+	for j := 0; j < k; j++ {
+		a = a.Add(e[j].Multiply(la[j]))
+		b = b.Add(e[j].Multiply(lb[j]))
+	}
+
+	// |a + b*r|^2 = (r - r0)^2
+	qa := b.Dot(b) - 1
+	qb := 2 * (a.Dot(b) + r0)
+	qc := a.Dot(a) - r0*r0
+	minRadius := 0.0
+	for _, s := range support {
+		minRadius = math.Max(minRadius, s.Radius)
+	}
+
+	radius := math.Inf(1)
+	if math.Abs(qa) < 1e-12 {
+		if qb != 0 {
+			radius = -qc / qb
+		}
+	} else {
+		disc := qb*qb - 4*qa*qc
+		if disc < 0 {
+			return degenerateSupport(support)
+		}
+		sqrt := math.Sqrt(disc)
+		for _, root := range [2]float64{(-qb - sqrt) / (2 * qa), (-qb + sqrt) / (2 * qa)} {
+			if root >= minRadius-1e-9 && root < radius {
+				radius = root
+			}
+		}
+	}
+	if math.IsInf(radius, 1) {
+		return degenerateSupport(support)
+	}
+	return Sphere{Center: c0.Add(a).Add(b.Multiply(radius)), Radius: radius}
+}
+
+// degenerateSupport returns the smallest ball fixed by a pair of the support balls that contains the rest.
+func degenerateSupport(support []Sphere) Sphere {
+	best := Sphere{Radius: math.Inf(1)}
+	for i := range support {
+		for j := i + 1; j < len(support); j++ {
+			candidate := ballFromSupport([]Sphere{support[i], support[j]})
+			if candidate.Radius >= best.Radius {
+				continue
+			}
+			containsAll := true
+			for _, s := range support {
+				if !ballContains(candidate, s) {
+					containsAll = false
+					break
+				}
+			}
+			if containsAll {
+				best = candidate
+			}
+		}
+	}
+	return best
+}
+
+// solveLinear solves the k by k system m * x = v with Gaussian elimination.
+func solveLinear(m [3][3]float64, v [3]float64, k int) ([3]float64, bool) {
+	for col := 0; col < k; col++ {
+		pivot := col
+		for row := col + 1; row < k; row++ {
+			if math.Abs(m[row][col]) > math.Abs(m[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(m[pivot][col]) < 1e-12 {
+			return v, false
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+		v[col], v[pivot] = v[pivot], v[col]
+
+		for row := col + 1; row < k; row++ {
+			f := m[row][col] / m[col][col]
+			for c := col; c < k; c++ {
+				m[row][c] -= f * m[col][c]
+			}
+			v[row] -= f * v[col]
+		}
+	}
+
+	var x [3]float64
+	for row := k - 1; row >= 0; row-- {
+		sum := v[row]
+		for c := row + 1; c < k; c++ {
+			sum -= m[row][c] * x[c]
+		}
+		x[row] = sum / m[row][row]
+	}
+	return x, true
+}
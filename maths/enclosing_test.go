@@ -0,0 +1,149 @@
+package maths_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestEnclosingCircle(t *testing.T) {
+	cases := map[string]struct {
+		points   []maths.Vector2
+		expected maths.Circle
+	}{
+		"single": {
+			points:   []maths.Vector2{{X: 1, Y: 2}},
+			expected: maths.Circle{Center: maths.Vector2{X: 1, Y: 2}},
+		},
+		"pair": {
+			points:   []maths.Vector2{{X: 0, Y: 0}, {X: 4, Y: 0}},
+			expected: maths.Circle{Center: maths.Vector2{X: 2}, Radius: 2},
+		},
+		"obtuse triangle uses its longest side": {
+			points:   []maths.Vector2{{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 2, Y: 0.5}},
+			expected: maths.Circle{Center: maths.Vector2{X: 2}, Radius: 2},
+		},
+		"square": {
+			points:   []maths.Vector2{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}, {X: 1, Y: 1}},
+			expected: maths.Circle{Center: maths.Vector2{X: 1, Y: 1}, Radius: math.Sqrt2},
+		},
+		"collinear": {
+			points:   []maths.Vector2{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 3, Y: 3}, {X: 2, Y: 2}},
+			expected: maths.Circle{Center: maths.Vector2{X: 1.5, Y: 1.5}, Radius: 1.5 * math.Sqrt2},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			circle := maths.EnclosingCircle(c.points)
+			assertVector2InDelta(t, c.expected.Center, circle.Center, 1e-9)
+			assert.InDelta(t, c.expected.Radius, circle.Radius, 1e-9)
+		})
+	}
+}
+
+func TestEnclosingCircle_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	points := make([]maths.Vector2, 200)
+	for i := range points {
+		points[i] = maths.Vector2{X: r.Float64() * 100, Y: r.Float64() * 50}
+	}
+
+	circle := maths.EnclosingCircle(points)
+	onEdge := 0
+	for _, p := range points {
+		d := circle.Center.Distance(p)
+		assert.LessOrEqual(t, d, circle.Radius+1e-9)
+		if math.Abs(d-circle.Radius) < 1e-9 {
+			onEdge++
+		}
+	}
+	assert.GreaterOrEqual(t, onEdge, 2, "a minimal circle touches at least two points")
+}
+
+func TestEnclosingCircleOfCircles(t *testing.T) {
+	circles := []maths.Circle{
+		maths.NewCircle(maths.Vector2{X: 0}, 1),
+		maths.NewCircle(maths.Vector2{X: 10}, 3),
+		maths.NewCircle(maths.Vector2{X: 5}, 1),
+	}
+	circle := maths.EnclosingCircleOfCircles(circles)
+	assertVector2InDelta(t, maths.Vector2{X: 6}, circle.Center, 1e-9)
+	assert.InDelta(t, 7, circle.Radius, 1e-9)
+
+	// three equal circles on a triangle
+	circles = []maths.Circle{
+		maths.NewCircle(maths.Vector2{X: 1}, 0.5),
+		maths.NewCircle(maths.Vector2{X: 1}.Rotate(2*math.Pi/3), 0.5),
+		maths.NewCircle(maths.Vector2{X: 1}.Rotate(4*math.Pi/3), 0.5),
+		maths.NewCircle(maths.Vector2{}, 1.2),
+	}
+	circle = maths.EnclosingCircleOfCircles(circles)
+	assertVector2InDelta(t, maths.Vector2{}, circle.Center, 1e-9)
+	assert.InDelta(t, 1.5, circle.Radius, 1e-9)
+}
+
+func TestEnclosingSphere(t *testing.T) {
+	points := []maths.Vector3{
+		{X: 1}, {X: -1}, {Y: 1}, {Y: -1}, {Z: 1}, {Z: -1}, {X: 0.5, Y: 0.5, Z: 0.5},
+	}
+	sphere := maths.EnclosingSphere(points)
+	assertVector3InDelta(t, maths.Vector3{}, sphere.Center)
+	assert.InDelta(t, 1, sphere.Radius, 1e-9)
+
+	r := rand.New(rand.NewSource(1))
+	points = make([]maths.Vector3, 200)
+	for i := range points {
+		points[i] = maths.Vector3{X: r.Float64(), Y: r.Float64() * 2, Z: r.Float64() * 3}
+	}
+	sphere = maths.EnclosingSphere(points)
+	for _, p := range points {
+		assert.LessOrEqual(t, sphere.Center.Distance(p), sphere.Radius+1e-9)
+	}
+	assert.Less(t, sphere.Radius, maths.Vector3{X: 1, Y: 2, Z: 3}.Magnitude()/2+1e-9)
+
+	spheres := []maths.Sphere{
+		maths.NewSphere(maths.Vector3{Z: -4}, 1),
+		maths.NewSphere(maths.Vector3{Z: 4}, 1),
+		maths.NewSphere(maths.Vector3{X: 1}, 2),
+	}
+	sphere = maths.EnclosingSphereOfSpheres(spheres)
+	assertVector3InDelta(t, maths.Vector3{}, sphere.Center)
+	assert.InDelta(t, 5, sphere.Radius, 1e-9)
+}
+
+func TestConvexHull(t *testing.T) {
+	points := []maths.Vector2{{X: 0, Y: 0}, {X: 2, Y: 2}, {X: 1, Y: 1}, {X: 2, Y: 0}, {X: 0, Y: 2}, {X: 1, Y: 0}, {X: 0.5, Y: 1.5}}
+	hull := maths.ConvexHull(points)
+	assert.Equal(t, []maths.Vector2{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}}, hull.Vertices)
+	assert.Greater(t, hull.SignedArea(), 0.0)
+
+	assert.Len(t, maths.ConvexHull([]maths.Vector2{{X: 0}, {X: 1}, {X: 2}}).Vertices, 2, "collinear")
+}
+
+func TestConvexHull3D(t *testing.T) {
+	// the corners of a cube with points inside and on the faces
+	var points []maths.Vector3
+	for i := 0; i < 8; i++ {
+		points = append(points, maths.Vector3{X: float64(i & 1), Y: float64(i >> 1 & 1), Z: float64(i >> 2 & 1)})
+	}
+	points = append(points, maths.Vector3{X: 0.5, Y: 0.5, Z: 0.5}, maths.Vector3{X: 0.2, Y: 0.3, Z: 0.9}, maths.Vector3{X: 0.5, Y: 0.5, Z: 1})
+
+	faces := maths.ConvexHull3D(points)
+	var area float64
+	center := maths.Vector3{X: 0.5, Y: 0.5, Z: 0.5}
+	for _, f := range faces {
+		a, b, c := points[f[0]], points[f[1]], points[f[2]]
+		normal := b.Sub(a).Cross(c.Sub(a))
+		area += normal.Magnitude() / 2
+		assert.Greater(t, normal.Dot(a.Sub(center)), 0.0, "faces wind outwards")
+		for _, p := range points {
+			assert.LessOrEqual(t, normal.Dot(p.Sub(a)), 1e-9, "no point outside a face")
+		}
+	}
+	assert.InDelta(t, 6, area, 1e-9)
+
+	assert.Nil(t, maths.ConvexHull3D([]maths.Vector3{{X: 0}, {X: 1}, {Y: 1}, {X: 1, Y: 1}}), "flat")
+}
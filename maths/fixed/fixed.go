@@ -0,0 +1,171 @@
+// Package fixed provides Q32.32 fixed-point numbers, and vectors and shapes built on them, for
+// simulations that must give the same results on every platform.
+//
+// The trees in package space work in float64, so fixed shapes are converted with ToMaths before
+// they are inserted. A float64 holds 53 significant bits to the 63 of a Fixed, so values of
+// magnitude 2^21 or more lose their lowest fractional bits in the conversion. The rounding is
+// deterministic, so tree queries still agree across platforms, but they see slightly coarser
+// shapes; confirm their results with the fixed-point tests when exactness matters.
+package fixed
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Fixed is a signed Q32.32 fixed-point number.
+// All arithmetic is done with integer operations so results are identical on every platform.
+// Overflow wraps, except for Div which saturates.
+type Fixed int64
+
+const (
+	FracBits = 32
+
+	One  Fixed = 1 << FracBits
+	Half Fixed = One >> 1
+
+	MaxValue Fixed = math.MaxInt64
+	MinValue Fixed = math.MinInt64
+
+	Pi        Fixed = 13493037705
+	HalfPi    Fixed = 6746518852
+	QuarterPi Fixed = 3373259426
+	TwoPi     Fixed = 26986075409
+)
+
+func FromInt(i int64) Fixed {
+	return Fixed(i << FracBits)
+}
+
+// FromFloat converts a float to the nearest fixed-point value.
+// This should only be used for authoring data, never inside a simulation step.
+func FromFloat(f float64) Fixed {
+	return Fixed(math.Round(f * float64(One)))
+}
+
+// FromRatio returns num / den without going through floating point.
+func FromRatio(num, den int64) Fixed {
+	return FromInt(num).Div(FromInt(den))
+}
+
+func (f Fixed) Float() float64 {
+	return float64(f) / float64(One)
+}
+
+// Int returns the integer part of f, rounded towards negative infinity.
+func (f Fixed) Int() int64 {
+	return int64(f >> FracBits)
+}
+
+func (f Fixed) Add(f2 Fixed) Fixed {
+	return f + f2
+}
+
+func (f Fixed) Sub(f2 Fixed) Fixed {
+	return f - f2
+}
+
+func (f Fixed) Mul(f2 Fixed) Fixed {
+	neg := (f < 0) != (f2 < 0)
+	hi, lo := bits.Mul64(abs64(f), abs64(f2))
+	r := Fixed(hi<<FracBits | lo>>FracBits)
+	if neg {
+		return -r
+	}
+	return r
+}
+
+// Div divides f by f2, saturating to MaxValue or MinValue if the result does not fit.
+// It panics if f2 is zero.
+func (f Fixed) Div(f2 Fixed) Fixed {
+	if f2 == 0 {
+		panic("fixed: division by zero")
+	}
+	neg := (f < 0) != (f2 < 0)
+	a, b := abs64(f), abs64(f2)
+	hi, lo := a>>(64-FracBits), a<<FracBits
+	if hi >= b {
+		if neg {
+			return MinValue
+		}
+		return MaxValue
+	}
+	q, _ := bits.Div64(hi, lo, b)
+	if q > math.MaxInt64 {
+		if neg {
+			return MinValue
+		}
+		return MaxValue
+	}
+	if neg {
+		return -Fixed(q)
+	}
+	return Fixed(q)
+}
+
+func (f Fixed) Abs() Fixed {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+func (f Fixed) Floor() Fixed {
+	return f &^ (One - 1)
+}
+
+func (f Fixed) Ceil() Fixed {
+	return (f + One - 1).Floor()
+}
+
+// Sqrt returns the square root of f. Negative values return zero.
+func (f Fixed) Sqrt() Fixed {
+	if f <= 0 {
+		return 0
+	}
+
+	// sqrt(f / 2^32) * 2^32 == sqrt(f * 2^32), so take the integer root of the 96 bit value f << 32
+	hi, lo := uint64(f)>>(64-FracBits), uint64(f)<<FracBits
+	var length int
+	if hi > 0 {
+		length = 64 + bits.Len64(hi)
+	} else {
+		length = bits.Len64(lo)
+	}
+
+	// start above the root so newton's method converges downwards
+	x := uint64(1) << ((length + 1) / 2)
+	for {
+		q, _ := bits.Div64(hi, lo, x)
+		y := (x + q) / 2
+		if y >= x {
+			return Fixed(x)
+		}
+		x = y
+	}
+}
+
+func Min(a, b Fixed) Fixed {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func Max(a, b Fixed) Fixed {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func Clamp(f, min, max Fixed) Fixed {
+	return Max(min, Min(max, f))
+}
+
+func abs64(f Fixed) uint64 {
+	if f < 0 {
+		return uint64(-f)
+	}
+	return uint64(f)
+}
@@ -0,0 +1,74 @@
+package fixed_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths/fixed"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestFixed_Arithmetic(t *testing.T) {
+	cases := map[string]struct {
+		a, b float64
+	}{
+		"positive":       {a: 3.25, b: 1.5},
+		"negative":       {a: -3.25, b: 1.5},
+		"both negative":  {a: -7.125, b: -0.25},
+		"small":          {a: 0.001, b: 0.002},
+		"large":          {a: 123456.75, b: 321.5},
+		"fractional div": {a: 1, b: 3},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			a, b := fixed.FromFloat(c.a), fixed.FromFloat(c.b)
+			assert.InDelta(t, c.a+c.b, a.Add(b).Float(), 1e-9)
+			assert.InDelta(t, c.a-c.b, a.Sub(b).Float(), 1e-9)
+			assert.InDelta(t, c.a*c.b, a.Mul(b).Float(), 1e-6)
+			assert.InDelta(t, c.a/c.b, a.Div(b).Float(), 1e-6)
+		})
+	}
+}
+
+func TestFixed_Conversions(t *testing.T) {
+	assert.Equal(t, fixed.One, fixed.FromInt(1))
+	assert.Equal(t, fixed.Half, fixed.FromFloat(0.5))
+	assert.Equal(t, fixed.FromFloat(0.75), fixed.FromRatio(3, 4))
+	assert.Equal(t, int64(2), fixed.FromFloat(2.75).Int())
+	assert.Equal(t, int64(-3), fixed.FromFloat(-2.25).Int())
+	assert.Equal(t, fixed.FromInt(-3), fixed.FromFloat(-2.25).Floor())
+	assert.Equal(t, fixed.FromInt(-2), fixed.FromFloat(-2.25).Ceil())
+	assert.Equal(t, fixed.FromInt(3), fixed.FromFloat(2.25).Ceil())
+	assert.Equal(t, fixed.FromInt(3), fixed.FromInt(3).Ceil())
+}
+
+func TestFixed_FloatPrecision(t *testing.T) {
+	// every value that fits in the 53 bits of a float64 survives the round trip
+	limit := fixed.Fixed(1<<53 - 1)
+	assert.Equal(t, limit, fixed.FromFloat(limit.Float()))
+	assert.Equal(t, -limit, fixed.FromFloat((-limit).Float()))
+
+	// wider values lose their lowest bits, which is what the trees see after ToMaths
+	wide := fixed.Fixed(1<<53 + 1)
+	assert.Equal(t, fixed.Fixed(1<<53), fixed.FromFloat(wide.Float()))
+	rect := fixed.Rectangle{X: wide, Y: limit, Width: fixed.One, Height: fixed.One}.ToMaths()
+	assert.Equal(t, math.Pow(2, 21), rect.X)
+	assert.Equal(t, limit.Float(), rect.Y)
+	circle := fixed.NewCircle(fixed.Vector2{X: wide}, wide).ToMaths()
+	assert.Equal(t, math.Pow(2, 21), circle.Center.X)
+	assert.Equal(t, math.Pow(2, 21), circle.Radius)
+}
+
+func TestFixed_Div(t *testing.T) {
+	assert.Equal(t, fixed.MaxValue, fixed.FromInt(1<<30).Div(fixed.FromFloat(0.001)))
+	assert.Equal(t, fixed.MinValue, fixed.FromInt(-1<<30).Div(fixed.FromFloat(0.001)))
+	assert.Panics(t, func() { fixed.One.Div(0) })
+}
+
+func TestFixed_Sqrt(t *testing.T) {
+	for _, f := range []float64{0, 0.0001, 0.25, 1, 2, 10, 12345.678, 2e9} {
+		assert.InDelta(t, math.Sqrt(f), fixed.FromFloat(f).Sqrt().Float(), 1e-8, "sqrt(%v)", f)
+	}
+	assert.Equal(t, fixed.FromInt(3), fixed.FromInt(9).Sqrt())
+	assert.Equal(t, fixed.Fixed(0), fixed.FromInt(-4).Sqrt())
+}
@@ -0,0 +1,69 @@
+package fixed
+
+import "github.com/soupstoregames/gamelib/maths"
+
+// Rectangle is a fixed-point axis aligned rectangle.
+// Use ToMaths to insert it into a space.QuadTree.
+type Rectangle struct {
+	X      Fixed
+	Y      Fixed
+	Width  Fixed
+	Height Fixed
+}
+
+func RectangleFromMaths(r maths.Rectangle) Rectangle {
+	return Rectangle{X: FromFloat(r.X), Y: FromFloat(r.Y), Width: FromFloat(r.Width), Height: FromFloat(r.Height)}
+}
+
+func (r Rectangle) ContainsVec(v Vector2) bool {
+	return r.X <= v.X && r.Y <= v.Y && r.X+r.Width > v.X && r.Y+r.Height > v.Y
+}
+
+func (r Rectangle) ContainsRect(r2 Rectangle) bool {
+	return r.X <= r2.X && r.X+r.Width >= r2.X+r2.Width && r.Y <= r2.Y && r.Y+r.Height >= r2.Y+r2.Height
+}
+
+func (r Rectangle) Intersects(r2 Rectangle) bool {
+	return !(r2.X > r.X+r.Width || r2.X+r2.Width < r.X || r2.Y > r.Y+r.Height || r2.Y+r2.Height < r.Y)
+}
+
+// ToMaths converts r to floating point, rounding values of magnitude 2^21 or more.
+func (r Rectangle) ToMaths() maths.Rectangle {
+	return maths.Rectangle{X: r.X.Float(), Y: r.Y.Float(), Width: r.Width.Float(), Height: r.Height.Float()}
+}
+
+// Circle is a fixed-point circle.
+// Use ToMaths to insert it into a space.CircleTree.
+type Circle struct {
+	Center Vector2
+	Radius Fixed
+}
+
+func NewCircle(center Vector2, radius Fixed) Circle {
+	return Circle{
+		Center: center,
+		Radius: radius,
+	}
+}
+
+func CircleFromMaths(c maths.Circle) Circle {
+	return Circle{Center: Vector2FromMaths(c.Center), Radius: FromFloat(c.Radius)}
+}
+
+func (s Circle) ContainsVec(v Vector2) bool {
+	return s.Center.Distance2(v) <= s.Radius.Mul(s.Radius)
+}
+
+func (s Circle) IntersectsCircle(s2 Circle) bool {
+	r := s.Radius + s2.Radius
+	return s.Center.Distance2(s2.Center) < r.Mul(r)
+}
+
+func (s Circle) ContainsCircle(s2 Circle) bool {
+	return s.Radius >= s.Center.Distance(s2.Center)+s2.Radius
+}
+
+// ToMaths converts s to floating point, rounding values of magnitude 2^21 or more.
+func (s Circle) ToMaths() maths.Circle {
+	return maths.Circle{Center: s.Center.ToMaths(), Radius: s.Radius.Float()}
+}
@@ -0,0 +1,85 @@
+package fixed
+
+// sinFactors are the denominators of the Taylor series for sin in Horner form:
+// x(1 - x²/(2*3)(1 - x²/(4*5)(1 - ...)))
+var sinFactors = [...]int64{2 * 3, 4 * 5, 6 * 7, 8 * 9, 10 * 11, 12 * 13, 14 * 15}
+
+// Sin returns the sine of the angle in radians.
+func Sin(angle Fixed) Fixed {
+	// wrap into [-Pi, Pi]
+	angle %= TwoPi
+	if angle > Pi {
+		angle -= TwoPi
+	} else if angle < -Pi {
+		angle += TwoPi
+	}
+
+	// mirror into [-Pi/2, Pi/2] where the series converges quickly
+	if angle > HalfPi {
+		angle = Pi - angle
+	} else if angle < -HalfPi {
+		angle = -Pi - angle
+	}
+
+	x2 := angle.Mul(angle)
+	r := One
+	for i := len(sinFactors) - 1; i >= 0; i-- {
+		r = One - x2.Mul(r)/Fixed(sinFactors[i])
+	}
+	return angle.Mul(r)
+}
+
+// Cos returns the cosine of the angle in radians.
+func Cos(angle Fixed) Fixed {
+	return Sin(angle%TwoPi + HalfPi)
+}
+
+// tanEighthPi is tan(Pi/8), the point where atan switches to the reduced argument.
+const tanEighthPi Fixed = 1779033704
+
+// atan returns the arc tangent of z in the range [0, 1].
+func atan(z Fixed) Fixed {
+	// atan(z) = Pi/4 + atan((z-1)/(z+1)) keeps the series argument below tan(Pi/8)
+	offset := Fixed(0)
+	if z > tanEighthPi {
+		z = (z - One).Div(z + One)
+		offset = QuarterPi
+	}
+
+	// atan(z) = z - z³/3 + z⁵/5 - ...
+	z2 := z.Mul(z)
+	term := z
+	var sum Fixed
+	for n := int64(1); term != 0; n += 2 {
+		if n%4 == 1 {
+			sum += term / Fixed(n)
+		} else {
+			sum -= term / Fixed(n)
+		}
+		term = term.Mul(z2)
+	}
+	return offset + sum
+}
+
+// Atan2 returns the angle in radians of the vector (x, y) from the positive X axis, in the range [-Pi, Pi].
+func Atan2(y, x Fixed) Fixed {
+	if x == 0 && y == 0 {
+		return 0
+	}
+
+	ax, ay := x.Abs(), y.Abs()
+	var a Fixed
+	if ay <= ax {
+		a = atan(ay.Div(ax))
+	} else {
+		a = HalfPi - atan(ax.Div(ay))
+	}
+
+	if x < 0 {
+		a = Pi - a
+	}
+	if y < 0 {
+		a = -a
+	}
+	return a
+}
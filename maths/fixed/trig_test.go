@@ -0,0 +1,58 @@
+package fixed_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths/fixed"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestSinCos(t *testing.T) {
+	for a := -10.0; a <= 10; a += 0.05 {
+		f := fixed.FromFloat(a)
+		assert.InDelta(t, math.Sin(a), fixed.Sin(f).Float(), 1e-8, "sin(%v)", a)
+		assert.InDelta(t, math.Cos(a), fixed.Cos(f).Float(), 1e-8, "cos(%v)", a)
+	}
+}
+
+func TestAtan2(t *testing.T) {
+	// start just past -Pi since y quantizes to zero there and the result flips to +Pi
+	for a := -math.Pi + 0.025; a <= math.Pi; a += 0.05 {
+		for _, r := range []float64{0.01, 1, 500} {
+			x, y := r*math.Cos(a), r*math.Sin(a)
+			expected := math.Atan2(y, x)
+			actual := fixed.Atan2(fixed.FromFloat(y), fixed.FromFloat(x)).Float()
+			// quantizing tiny vectors to fixed-point moves their angle slightly
+			assert.InDelta(t, expected, actual, 1e-6, "atan2(%v, %v)", y, x)
+		}
+	}
+	assert.Equal(t, fixed.Fixed(0), fixed.Atan2(0, 0))
+	assert.Equal(t, fixed.HalfPi, fixed.Atan2(fixed.One, 0))
+	assert.Equal(t, fixed.Pi, fixed.Atan2(0, -fixed.One))
+}
+
+func TestVector2_Rotate(t *testing.T) {
+	v := fixed.Vector2{X: fixed.FromInt(3), Y: fixed.FromInt(4)}
+	assert.InDelta(t, 5, v.Magnitude().Float(), 1e-9)
+	assert.InDelta(t, 1, v.Normalize().Magnitude().Float(), 1e-8)
+
+	r := v.Rotate(fixed.HalfPi).ToMaths()
+	assert.InDelta(t, -4, r.X, 1e-7)
+	assert.InDelta(t, 3, r.Y, 1e-7)
+	assert.InDelta(t, math.Atan2(4, 3), v.Angle().Float(), 1e-8)
+}
+
+func TestCircle_IntersectsCircle(t *testing.T) {
+	a := fixed.NewCircle(fixed.Vector2{}, fixed.FromInt(3))
+	b := fixed.NewCircle(fixed.Vector2{X: fixed.FromInt(5)}, fixed.FromInt(2))
+	c := fixed.NewCircle(fixed.Vector2{X: fixed.FromInt(5)}, fixed.FromFloat(2.1))
+
+	assert.False(t, a.IntersectsCircle(b))
+	assert.True(t, a.IntersectsCircle(c))
+	assert.True(t, a.ContainsCircle(fixed.NewCircle(fixed.Vector2{X: fixed.One}, fixed.One)))
+	assert.Equal(t, a.ToMaths().IntersectsCircle(c.ToMaths()), a.IntersectsCircle(c))
+
+	rect := fixed.Rectangle{Width: fixed.FromInt(2), Height: fixed.FromInt(2)}
+	assert.True(t, rect.ContainsVec(fixed.Vector2{X: fixed.One, Y: fixed.One}))
+	assert.Equal(t, 2.0, rect.ToMaths().Width)
+}
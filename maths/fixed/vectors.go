@@ -0,0 +1,167 @@
+package fixed
+
+import "github.com/soupstoregames/gamelib/maths"
+
+type Vector2 struct {
+	X Fixed
+	Y Fixed
+}
+
+func Vector2FromMaths(v maths.Vector2) Vector2 {
+	return Vector2{X: FromFloat(v.X), Y: FromFloat(v.Y)}
+}
+
+func (v Vector2) Add(v2 Vector2) Vector2 {
+	v.X += v2.X
+	v.Y += v2.Y
+	return v
+}
+
+func (v Vector2) Sub(v2 Vector2) Vector2 {
+	v.X -= v2.X
+	v.Y -= v2.Y
+	return v
+}
+
+func (v Vector2) Multiply(scalar Fixed) Vector2 {
+	v.X = v.X.Mul(scalar)
+	v.Y = v.Y.Mul(scalar)
+	return v
+}
+
+func (v Vector2) Divide(scalar Fixed) Vector2 {
+	v.X = v.X.Div(scalar)
+	v.Y = v.Y.Div(scalar)
+	return v
+}
+
+func (v Vector2) Dot(v2 Vector2) Fixed {
+	return v.X.Mul(v2.X) + v.Y.Mul(v2.Y)
+}
+
+// Cross returns the z component of the 3D cross product.
+func (v Vector2) Cross(v2 Vector2) Fixed {
+	return v.X.Mul(v2.Y) - v.Y.Mul(v2.X)
+}
+
+func (v Vector2) Magnitude() Fixed {
+	return v.Magnitude2().Sqrt()
+}
+
+func (v Vector2) Magnitude2() Fixed {
+	return v.Dot(v)
+}
+
+// Normalize returns a unit vector in the same direction. The zero vector is returned unchanged.
+func (v Vector2) Normalize() Vector2 {
+	div := v.Magnitude()
+	if div == 0 {
+		return v
+	}
+	return v.Divide(div)
+}
+
+func (v Vector2) Distance(v2 Vector2) Fixed {
+	return v.Sub(v2).Magnitude()
+}
+
+func (v Vector2) Distance2(v2 Vector2) Fixed {
+	return v.Sub(v2).Magnitude2()
+}
+
+// Angle returns the angle in radians from the positive X axis, in the range [-Pi, Pi].
+func (v Vector2) Angle() Fixed {
+	return Atan2(v.Y, v.X)
+}
+
+// Rotate rotates v counter-clockwise by angle radians.
+func (v Vector2) Rotate(angle Fixed) Vector2 {
+	sin, cos := Sin(angle), Cos(angle)
+	return Vector2{
+		X: v.X.Mul(cos) - v.Y.Mul(sin),
+		Y: v.X.Mul(sin) + v.Y.Mul(cos),
+	}
+}
+
+func (v Vector2) ToMaths() maths.Vector2 {
+	return maths.Vector2{X: v.X.Float(), Y: v.Y.Float()}
+}
+
+type Vector3 struct {
+	X Fixed
+	Y Fixed
+	Z Fixed
+}
+
+func Vector3FromMaths(v maths.Vector3) Vector3 {
+	return Vector3{X: FromFloat(v.X), Y: FromFloat(v.Y), Z: FromFloat(v.Z)}
+}
+
+func (v Vector3) Add(v2 Vector3) Vector3 {
+	v.X += v2.X
+	v.Y += v2.Y
+	v.Z += v2.Z
+	return v
+}
+
+func (v Vector3) Sub(v2 Vector3) Vector3 {
+	v.X -= v2.X
+	v.Y -= v2.Y
+	v.Z -= v2.Z
+	return v
+}
+
+func (v Vector3) Multiply(scalar Fixed) Vector3 {
+	v.X = v.X.Mul(scalar)
+	v.Y = v.Y.Mul(scalar)
+	v.Z = v.Z.Mul(scalar)
+	return v
+}
+
+func (v Vector3) Divide(scalar Fixed) Vector3 {
+	v.X = v.X.Div(scalar)
+	v.Y = v.Y.Div(scalar)
+	v.Z = v.Z.Div(scalar)
+	return v
+}
+
+func (v Vector3) Dot(v2 Vector3) Fixed {
+	return v.X.Mul(v2.X) + v.Y.Mul(v2.Y) + v.Z.Mul(v2.Z)
+}
+
+func (v Vector3) Cross(v2 Vector3) Vector3 {
+	return Vector3{
+		X: v.Y.Mul(v2.Z) - v.Z.Mul(v2.Y),
+		Y: v.Z.Mul(v2.X) - v.X.Mul(v2.Z),
+		Z: v.X.Mul(v2.Y) - v.Y.Mul(v2.X),
+	}
+}
+
+func (v Vector3) Magnitude() Fixed {
+	return v.Magnitude2().Sqrt()
+}
+
+func (v Vector3) Magnitude2() Fixed {
+	return v.Dot(v)
+}
+
+// Normalize returns a unit vector in the same direction. The zero vector is returned unchanged.
+func (v Vector3) Normalize() Vector3 {
+	div := v.Magnitude()
+	if div == 0 {
+		return v
+	}
+	return v.Divide(div)
+}
+
+func (v Vector3) Distance(v2 Vector3) Fixed {
+	return v.Sub(v2).Magnitude()
+}
+
+func (v Vector3) Distance2(v2 Vector3) Fixed {
+	return v.Sub(v2).Magnitude2()
+}
+
+func (v Vector3) ToMaths() maths.Vector3 {
+	return maths.Vector3{X: v.X.Float(), Y: v.Y.Float(), Z: v.Z.Float()}
+}
@@ -0,0 +1,166 @@
+package maths
+
+import "math"
+
+const (
+	gjkMaxIterations = 32
+	epaMaxIterations = 64
+	epaTolerance     = 1e-9
+)
+
+// Support returns the point of the shape furthest in direction d, or the zero vector for a shape
+// without vertices.
+func Support(s Shape, d Vector2) Vector2 {
+	core, radius := s.Core()
+	if len(core) == 0 {
+		return Vector2{}
+	}
+	best := core[0]
+	bestDot := best.Dot(d)
+	for _, v := range core[1:] {
+		if dot := v.Dot(d); dot > bestDot {
+			best = v
+			bestDot = dot
+		}
+	}
+	if radius > 0 {
+		best = best.Add(d.Normalize().Multiply(radius))
+	}
+	return best
+}
+
+// minkowskiSupport returns the support point of the Minkowski difference a - b.
+func minkowskiSupport(a, b Shape, d Vector2) Vector2 {
+	return Support(a, d).Sub(Support(b, d.Multiply(-1)))
+}
+
+// GJKIntersects reports whether two convex shapes overlap, not just touch, using the Gilbert-Johnson-Keerthi algorithm.
+func GJKIntersects(a, b Shape) bool {
+	_, ok := gjk(a, b)
+	return ok
+}
+
+// ConvexContact finds the contact between any two convex shapes using GJK to detect overlap
+// and the expanding polytope algorithm to find the penetration. It produces a single contact point.
+func ConvexContact(a, b Shape) (Manifold, bool) {
+	simplex, ok := gjk(a, b)
+	if !ok {
+		return Manifold{}, false
+	}
+
+	normal, depth := epa(a, b, simplex)
+	// the deepest point of b inside a, moved halfway back to a's surface
+	point := Support(b, normal.Multiply(-1)).Add(normal.Multiply(depth / 2))
+	return Manifold{
+		Normal:   normal,
+		Contacts: [2]ContactPoint{{Point: point, Depth: depth}},
+		Count:    1,
+	}, true
+}
+
+// gjk returns a triangle of the Minkowski difference that encloses the origin if the shapes overlap.
+func gjk(a, b Shape) ([]Vector2, bool) {
+	// a shape without vertices covers no points, so it overlaps nothing
+	if aCore, _ := a.Core(); len(aCore) == 0 {
+		return nil, false
+	}
+	if bCore, _ := b.Core(); len(bCore) == 0 {
+		return nil, false
+	}
+
+	d := b.Bounds().Centroid().Sub(a.Bounds().Centroid())
+	if d.Magnitude2() == 0 {
+		d = Vector2{X: 1}
+	}
+
+	simplex := make([]Vector2, 0, 3)
+	simplex = append(simplex, minkowskiSupport(a, b, d))
+	d = simplex[0].Multiply(-1)
+
+	for i := 0; i < gjkMaxIterations; i++ {
+		if d.Magnitude2() == 0 {
+			// the origin lies on the simplex so the shapes are at most touching
+			return nil, false
+		}
+
+		p := minkowskiSupport(a, b, d)
+		if p.Dot(d) <= 0 {
+			return nil, false
+		}
+		simplex = append(simplex, p)
+
+		if len(simplex) == 2 {
+			// search perpendicular to the line towards the origin
+			ab := simplex[0].Sub(simplex[1])
+			ao := simplex[1].Multiply(-1)
+			d = tripleProduct(ab, ao, ab)
+			if d.Magnitude2() == 0 {
+				// the origin is on the segment, so it is only inside if the difference reaches past both sides
+				d = Vector2{X: -ab.Y, Y: ab.X}
+				if minkowskiSupport(a, b, d.Multiply(-1)).Dot(d) >= 0 {
+					return nil, false
+				}
+			}
+			continue
+		}
+
+		// triangle case: c, b are older points, a is the newest
+		pa, pb, pc := simplex[2], simplex[1], simplex[0]
+		ab := pb.Sub(pa)
+		ac := pc.Sub(pa)
+		ao := pa.Multiply(-1)
+		abPerp := tripleProduct(ac, ab, ab)
+		acPerp := tripleProduct(ab, ac, ac)
+
+		// an origin on an edge keeps searching past it, so one on the boundary is never enclosed
+		if abPerp.Dot(ao) >= 0 {
+			simplex = []Vector2{pb, pa}
+			d = abPerp
+		} else if acPerp.Dot(ao) >= 0 {
+			simplex = []Vector2{pc, pa}
+			d = acPerp
+		} else {
+			return simplex, true
+		}
+	}
+	return nil, false
+}
+
+// tripleProduct returns (a x b) x c, which is perpendicular to c in the direction of a x b.
+func tripleProduct(a, b, c Vector2) Vector2 {
+	z := a.Cross(b)
+	return Vector2{X: -z * c.Y, Y: z * c.X}
+}
+
+// epa expands the simplex towards the boundary of the Minkowski difference to find the penetration.
+func epa(a, b Shape, simplex []Vector2) (Vector2, float64) {
+	polytope := counterClockwise(append([]Vector2(nil), simplex...))
+
+	var normal Vector2
+	var dist float64
+	for i := 0; i < epaMaxIterations; i++ {
+		edge := 0
+		dist = math.Inf(1)
+		for j := range polytope {
+			n := edgeNormal(polytope, j)
+			if n.Magnitude2() == 0 {
+				continue
+			}
+			if d := n.Dot(polytope[j]); d < dist {
+				dist = d
+				normal = n
+				edge = j
+			}
+		}
+
+		p := minkowskiSupport(a, b, normal)
+		if p.Dot(normal)-dist < epaTolerance {
+			break
+		}
+
+		polytope = append(polytope, Vector2{})
+		copy(polytope[edge+2:], polytope[edge+1:])
+		polytope[edge+1] = p
+	}
+	return normal, math.Max(0, dist)
+}
@@ -0,0 +1,176 @@
+package maths
+
+import (
+	"math"
+	"sort"
+)
+
+// ConvexHull returns the convex hull of the points wound counter-clockwise, using Andrew's monotone
+// chain. Collinear points along the hull are left out.
+func ConvexHull(points []Vector2) Polygon {
+	sorted := make([]Vector2, len(points))
+	copy(sorted, points)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].X != sorted[j].X {
+			return sorted[i].X < sorted[j].X
+		}
+		return sorted[i].Y < sorted[j].Y
+	})
+	if len(sorted) < 3 {
+		return Polygon{Vertices: sorted}
+	}
+
+	hull := make([]Vector2, 0, 2*len(sorted))
+	// lower hull left to right, then upper hull right to left
+	for pass := 0; pass < 2; pass++ {
+		start := len(hull)
+		for _, p := range sorted {
+			for len(hull) >= start+2 && orientation(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, p)
+		}
+		// the last point is the first point of the other chain
+		hull = hull[:len(hull)-1]
+		for i, j := 0, len(sorted)-1; i < j; i, j = i+1, j-1 {
+			sorted[i], sorted[j] = sorted[j], sorted[i]
+		}
+	}
+	return Polygon{Vertices: hull}
+}
+
+// ConvexHull3D returns the triangles of the convex hull of the points as indices into points.
+// Triangles wind counter-clockwise when viewed from outside the hull.
+// It returns nil if the points are all on one plane.
+func ConvexHull3D(points []Vector3) [][3]int {
+	first, ok := initialTetrahedron(points)
+	if !ok {
+		return nil
+	}
+
+	var centroid Vector3
+	for _, i := range first {
+		centroid = centroid.Add(points[i])
+	}
+	centroid = centroid.Multiply(0.25)
+
+	faces := make([][3]int, 0, 4)
+	for _, f := range [4][3]int{{0, 1, 2}, {0, 3, 1}, {0, 2, 3}, {1, 3, 2}} {
+		face := [3]int{first[f[0]], first[f[1]], first[f[2]]}
+		if faceDistance(points, face, centroid) > 0 {
+			face[1], face[2] = face[2], face[1]
+		}
+		faces = append(faces, face)
+	}
+
+	// add points one at a time, replacing the faces they can see with a fan from the horizon
+	extent := hullExtent(points)
+	eps := 1e-9 * extent * extent * extent
+	visible := make([]bool, 0, len(faces))
+	for p := range points {
+		if p == first[0] || p == first[1] || p == first[2] || p == first[3] {
+			continue
+		}
+
+		visible = visible[:0]
+		anyVisible := false
+		for _, f := range faces {
+			v := faceDistance(points, f, points[p]) > eps
+			visible = append(visible, v)
+			anyVisible = anyVisible || v
+		}
+		if !anyVisible {
+			continue
+		}
+
+		// an edge of a visible face is on the horizon if the face across it is hidden
+		edges := make(map[[2]int]bool)
+		for i, f := range faces {
+			if visible[i] {
+				for k := 0; k < 3; k++ {
+					edges[[2]int{f[k], f[(k+1)%3]}] = true
+				}
+			}
+		}
+
+		kept := faces[:0:0]
+		var added [][3]int
+		for i, f := range faces {
+			if !visible[i] {
+				kept = append(kept, f)
+				continue
+			}
+			for k := 0; k < 3; k++ {
+				a, b := f[k], f[(k+1)%3]
+				if !edges[[2]int{b, a}] {
+					added = append(added, [3]int{a, b, p})
+				}
+			}
+		}
+		faces = append(kept, added...)
+	}
+	return faces
+}
+
+// initialTetrahedron finds four points that are not on one plane.
+func initialTetrahedron(points []Vector3) ([4]int, bool) {
+	var t [4]int
+	if len(points) < 4 {
+		return t, false
+	}
+	eps := 1e-9 * hullExtent(points)
+
+	// the two extreme points along X, then the points furthest from their line and plane
+	for i, p := range points {
+		if p.X < points[t[0]].X {
+			t[0] = i
+		}
+		if p.X > points[t[1]].X {
+			t[1] = i
+		}
+	}
+	a, b := points[t[0]], points[t[1]]
+	if a.Distance(b) <= eps {
+		return t, false
+	}
+
+	best := 0.0
+	for i, p := range points {
+		if d := b.Sub(a).Cross(p.Sub(a)).Magnitude(); d > best {
+			best, t[2] = d, i
+		}
+	}
+	if best <= eps*a.Distance(b) {
+		return t, false
+	}
+
+	normal := b.Sub(a).Cross(points[t[2]].Sub(a)).Normalize()
+	best = 0
+	for i, p := range points {
+		if d := p.Sub(a).Dot(normal); d*d > best*best {
+			best, t[3] = d, i
+		}
+	}
+	if math.Abs(best) <= eps {
+		return t, false
+	}
+	return t, true
+}
+
+// faceDistance is how far v is in front of the face, scaled by twice the face's area.
+// The scaling keeps it cheap, so compare it against a tolerance in units of length cubed.
+func faceDistance(points []Vector3, f [3]int, v Vector3) float64 {
+	a, b, c := points[f[0]], points[f[1]], points[f[2]]
+	return b.Sub(a).Cross(c.Sub(a)).Dot(v.Sub(a))
+}
+
+// hullExtent is the largest absolute coordinate, or 1 for points close to the origin,
+// and sets the scale of the tolerances.
+func hullExtent(points []Vector3) float64 {
+	extent := 1.0
+	for _, p := range points {
+		a := p.Abs()
+		extent = math.Max(extent, math.Max(a.X, math.Max(a.Y, a.Z)))
+	}
+	return extent
+}
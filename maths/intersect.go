@@ -0,0 +1,189 @@
+package maths
+
+import "math"
+
+// Shape is a convex 2D shape that can be tested against any other Shape.
+// Shapes intersect only when they overlap, as with Rectangle.Intersects and Circle.IntersectsCircle;
+// shapes that just touch at an edge or a point do not. A segment or point overlaps a shape it
+// passes inside, and two segments overlap when they cross.
+type Shape interface {
+	Bounds() Rectangle
+	ContainsVec(v Vector2) bool
+	ClosestPoint(v Vector2) Vector2
+	// Core describes the shape as the convex hull of the vertices expanded by radius.
+	// A single vertex is a point and two vertices are a segment.
+	Core() ([]Vector2, float64)
+}
+
+func (r Rectangle) Core() ([]Vector2, float64) {
+	return r.ToPolygon().Vertices, 0
+}
+
+func (s Circle) Core() ([]Vector2, float64) {
+	return []Vector2{s.Center}, s.Radius
+}
+
+func (s Segment) Core() ([]Vector2, float64) {
+	return []Vector2{s.A, s.B}, 0
+}
+
+func (c Capsule) Core() ([]Vector2, float64) {
+	return []Vector2{c.A, c.B}, c.Radius
+}
+
+func (o OrientedRect) Core() ([]Vector2, float64) {
+	return o.ToPolygon().Vertices, 0
+}
+
+func (t Triangle) Core() ([]Vector2, float64) {
+	return []Vector2{t.A, t.B, t.C}, 0
+}
+
+func (p Polygon) Core() ([]Vector2, float64) {
+	return p.Vertices, 0
+}
+
+// Intersects reports whether two shapes overlap. Shapes that only touch do not intersect.
+func Intersects(a, b Shape) bool {
+	aCore, aRadius := a.Core()
+	bCore, bRadius := b.Core()
+	if radius := aRadius + bRadius; radius > 0 {
+		return coreDistance(aCore, bCore) < radius
+	}
+
+	// without a radius the cores themselves must overlap
+	if len(aCore) == 0 || len(bCore) == 0 {
+		return false
+	}
+	if (len(aCore) == 1 && len(bCore) < 3) || (len(bCore) == 1 && len(aCore) < 3) {
+		// a point can only be inside a polygon
+		return false
+	}
+	return !hasSeparatingAxis(aCore, bCore) && !hasSeparatingAxis(bCore, aCore)
+}
+
+// Contains reports whether b lies entirely inside a.
+func Contains(a, b Shape) bool {
+	aCore, aRadius := a.Core()
+	bCore, bRadius := b.Core()
+	// the point of b furthest outside a is always one of b's vertices pushed out by b's radius
+	for _, v := range bCore {
+		if coreSignedDistance(aCore, v)+bRadius > aRadius+Epsilon {
+			return false
+		}
+	}
+	return true
+}
+
+// Distance returns the gap between two shapes, or zero if they overlap.
+func Distance(a, b Shape) float64 {
+	aCore, aRadius := a.Core()
+	bCore, bRadius := b.Core()
+	return math.Max(0, coreDistance(aCore, bCore)-aRadius-bRadius)
+}
+
+// IntersectsPolygon tests two convex polygons with the separating axis theorem.
+func (p Polygon) IntersectsPolygon(p2 Polygon) bool {
+	return !hasSeparatingAxis(p.Vertices, p2.Vertices) && !hasSeparatingAxis(p2.Vertices, p.Vertices)
+}
+
+// hasSeparatingAxis checks the edge normals of a for an axis that separates a from b.
+// Projections that only meet count as separated.
+func hasSeparatingAxis(a, b []Vector2) bool {
+	for i := range a {
+		axis := a[(i+1)%len(a)].Sub(a[i]).Perpendicular()
+		if axis == (Vector2{}) {
+			// a repeated vertex has no edge
+			continue
+		}
+		aMin, aMax := projectOnto(a, axis)
+		bMin, bMax := projectOnto(b, axis)
+		if aMax <= bMin || bMax <= aMin {
+			return true
+		}
+	}
+	return false
+}
+
+func projectOnto(vertices []Vector2, axis Vector2) (float64, float64) {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, v := range vertices {
+		d := v.Dot(axis)
+		min = math.Min(min, d)
+		max = math.Max(max, d)
+	}
+	return min, max
+}
+
+// IntersectsSegment reports whether two segments cross. Segments that only touch, or that overlap
+// along the same line, do not intersect.
+func (s Segment) IntersectsSegment(s2 Segment) bool {
+	d1 := orientation(s2.A, s2.B, s.A)
+	d2 := orientation(s2.A, s2.B, s.B)
+	d3 := orientation(s.A, s.B, s2.A)
+	d4 := orientation(s.A, s.B, s2.B)
+	return ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0))
+}
+
+// DistanceToSegment returns the shortest distance between two segments.
+func (s Segment) DistanceToSegment(s2 Segment) float64 {
+	// segments that touch have an end on the other segment, which the closest points below find
+	if s.IntersectsSegment(s2) {
+		return 0
+	}
+	return math.Sqrt(math.Min(
+		math.Min(s.ClosestPoint(s2.A).Distance2(s2.A), s.ClosestPoint(s2.B).Distance2(s2.B)),
+		math.Min(s2.ClosestPoint(s.A).Distance2(s.A), s2.ClosestPoint(s.B).Distance2(s.B)),
+	))
+}
+
+func orientation(a, b, c Vector2) float64 {
+	return b.Sub(a).Cross(c.Sub(a))
+}
+
+// coreEdges returns the edges of a point, segment or polygon core.
+func coreEdges(core []Vector2) []Segment {
+	switch len(core) {
+	case 0:
+		return nil
+	case 1:
+		return []Segment{{A: core[0], B: core[0]}}
+	case 2:
+		return []Segment{{A: core[0], B: core[1]}}
+	}
+	edges := make([]Segment, len(core))
+	for i := range core {
+		edges[i] = Segment{A: core[i], B: core[(i+1)%len(core)]}
+	}
+	return edges
+}
+
+func coreDistance(a, b []Vector2) float64 {
+	if len(a) >= 3 && len(b) > 0 && (Polygon{Vertices: a}).ContainsVec(b[0]) {
+		return 0
+	}
+	if len(b) >= 3 && len(a) > 0 && (Polygon{Vertices: b}).ContainsVec(a[0]) {
+		return 0
+	}
+
+	dist := math.Inf(1)
+	bEdges := coreEdges(b)
+	for _, ea := range coreEdges(a) {
+		for _, eb := range bEdges {
+			dist = math.Min(dist, ea.DistanceToSegment(eb))
+		}
+	}
+	return dist
+}
+
+// coreSignedDistance returns the distance from v to the core, negated by the depth when v is inside a polygon core.
+func coreSignedDistance(core []Vector2, v Vector2) float64 {
+	dist := math.Inf(1)
+	for _, e := range coreEdges(core) {
+		dist = math.Min(dist, e.ClosestPoint(v).Distance(v))
+	}
+	if len(core) >= 3 && (Polygon{Vertices: core}).ContainsVec(v) {
+		return -dist
+	}
+	return dist
+}
@@ -0,0 +1,187 @@
+package maths_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestIntersects(t *testing.T) {
+	rect := maths.Rectangle{X: 0, Y: 0, Width: 2, Height: 2}
+	triangle := maths.Triangle{A: maths.Vector2{X: 3}, B: maths.Vector2{X: 5}, C: maths.Vector2{X: 3, Y: 2}}
+	diamond := maths.OrientedRect{Center: maths.Vector2{X: 3, Y: 3}, HalfExtents: maths.Vector2{X: 1, Y: 1}, Rotation: math.Pi / 4}
+
+	cases := map[string]struct {
+		a, b     maths.Shape
+		expected bool
+	}{
+		"rect vs circle overlapping": {
+			a: rect, b: maths.NewCircle(maths.Vector2{X: 3, Y: 1}, 1.5), expected: true,
+		},
+		"rect vs circle near corner": {
+			// the circle's bounds overlap the rect but the circle itself does not reach the corner
+			a: rect, b: maths.NewCircle(maths.Vector2{X: 3, Y: 3}, 1.2), expected: false,
+		},
+		"rect vs circle inside": {
+			a: rect, b: maths.NewCircle(maths.Vector2{X: 1, Y: 1}, 0.1), expected: true,
+		},
+		"circle vs segment crossing": {
+			a: maths.NewCircle(maths.Vector2{}, 1), b: maths.Segment{A: maths.Vector2{X: -5, Y: 0.5}, B: maths.Vector2{X: 5, Y: 0.5}}, expected: true,
+		},
+		"circle vs segment missing": {
+			a: maths.NewCircle(maths.Vector2{}, 1), b: maths.Segment{A: maths.Vector2{X: -5, Y: 1.5}, B: maths.Vector2{X: 5, Y: 1.5}}, expected: false,
+		},
+		"segment vs segment crossing": {
+			a: maths.Segment{A: maths.Vector2{}, B: maths.Vector2{X: 2, Y: 2}}, b: maths.Segment{A: maths.Vector2{Y: 2}, B: maths.Vector2{X: 2}}, expected: true,
+		},
+		"segment vs segment collinear apart": {
+			a: maths.Segment{A: maths.Vector2{}, B: maths.Vector2{X: 1}}, b: maths.Segment{A: maths.Vector2{X: 2}, B: maths.Vector2{X: 3}}, expected: false,
+		},
+		"polygon vs polygon separated": {
+			a: rect, b: triangle, expected: false,
+		},
+		"polygon vs polygon overlapping": {
+			a: rect, b: maths.Triangle{A: maths.Vector2{X: 1, Y: 1}, B: maths.Vector2{X: 5}, C: maths.Vector2{X: 3, Y: 2}}, expected: true,
+		},
+		"polygon vs rotated rect separated on diagonal": {
+			a: rect, b: diamond, expected: false,
+		},
+		"capsule vs rect": {
+			a: maths.Capsule{A: maths.Vector2{X: 2.5, Y: -5}, B: maths.Vector2{X: 2.5, Y: 5}, Radius: 1}, b: rect, expected: true,
+		},
+		"capsule vs capsule": {
+			a: maths.Capsule{A: maths.Vector2{X: 3, Y: -5}, B: maths.Vector2{X: 3, Y: 5}, Radius: 1},
+			b: maths.Capsule{A: maths.Vector2{X: 6, Y: -5}, B: maths.Vector2{X: 6, Y: 5}, Radius: 1}, expected: false,
+		},
+		"segment inside polygon": {
+			a: rect, b: maths.Segment{A: maths.Vector2{X: 0.5, Y: 0.5}, B: maths.Vector2{X: 1, Y: 1}}, expected: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expected, maths.Intersects(c.a, c.b))
+			assert.Equal(t, c.expected, maths.Intersects(c.b, c.a))
+		})
+	}
+}
+
+func TestContains(t *testing.T) {
+	rect := maths.Rectangle{X: 0, Y: 0, Width: 4, Height: 4}
+	cases := map[string]struct {
+		a, b     maths.Shape
+		expected bool
+	}{
+		"rect contains circle": {
+			a: rect, b: maths.NewCircle(maths.Vector2{X: 2, Y: 2}, 2), expected: true,
+		},
+		"rect does not contain circle poking out": {
+			a: rect, b: maths.NewCircle(maths.Vector2{X: 2, Y: 2}, 2.1), expected: false,
+		},
+		"circle contains rect": {
+			a: maths.NewCircle(maths.Vector2{X: 2, Y: 2}, 2*math.Sqrt2), b: rect, expected: true,
+		},
+		"circle does not contain rect corners": {
+			a: maths.NewCircle(maths.Vector2{X: 2, Y: 2}, 2.5), b: rect, expected: false,
+		},
+		"capsule contains circle": {
+			a: maths.Capsule{A: maths.Vector2{}, B: maths.Vector2{X: 10}, Radius: 2}, b: maths.NewCircle(maths.Vector2{X: 5, Y: 1}, 1), expected: true,
+		},
+		"polygon contains segment": {
+			a: rect, b: maths.Segment{A: maths.Vector2{X: 1, Y: 1}, B: maths.Vector2{X: 3, Y: 3}}, expected: true,
+		},
+		"triangle contains rect": {
+			a: maths.Triangle{A: maths.Vector2{}, B: maths.Vector2{X: 8}, C: maths.Vector2{Y: 8}}, b: rect, expected: true,
+		},
+		"triangle does not contain rect poking out": {
+			a: maths.Triangle{A: maths.Vector2{}, B: maths.Vector2{X: 7}, C: maths.Vector2{Y: 7}}, b: rect, expected: false,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expected, maths.Contains(c.a, c.b))
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	rect := maths.Rectangle{X: 0, Y: 0, Width: 2, Height: 2}
+	assert.InDelta(t, 1, maths.Distance(rect, maths.NewCircle(maths.Vector2{X: 4, Y: 1}, 1)), 1e-9)
+	assert.Equal(t, 0.0, maths.Distance(rect, maths.NewCircle(maths.Vector2{X: 1, Y: 1}, 1)))
+	assert.InDelta(t, math.Sqrt2, maths.Distance(rect, maths.Segment{A: maths.Vector2{X: 3, Y: 3}, B: maths.Vector2{X: 5, Y: 3}}), 1e-9)
+}
+
+func TestIntersects_Touching(t *testing.T) {
+	// touching shapes are zero distance apart but do not intersect
+	rect := maths.Rectangle{X: 0, Y: 0, Width: 2, Height: 2}
+	touching := map[string]struct{ a, b maths.Shape }{
+		"rect edge":              {a: rect, b: maths.Rectangle{X: 2, Y: 0, Width: 2, Height: 2}},
+		"rect corner":            {a: rect, b: maths.Rectangle{X: 2, Y: 2, Width: 2, Height: 2}},
+		"rect vs circle":         {a: rect, b: maths.NewCircle(maths.Vector2{X: 3, Y: 1}, 1)},
+		"circle vs circle":       {a: maths.NewCircle(maths.Vector2{}, 1), b: maths.NewCircle(maths.Vector2{X: 3}, 2)},
+		"segment end on edge":    {a: rect, b: maths.Segment{A: maths.Vector2{X: 2, Y: 1}, B: maths.Vector2{X: 4, Y: 1}}},
+		"segment along edge":     {a: rect, b: maths.Segment{A: maths.Vector2{X: -1}, B: maths.Vector2{X: 1}}},
+		"segment end on segment": {a: maths.Segment{B: maths.Vector2{X: 2}}, b: maths.Segment{A: maths.Vector2{X: 1}, B: maths.Vector2{X: 1, Y: 2}}},
+		"point on edge":          {a: rect, b: maths.Segment{A: maths.Vector2{X: 2, Y: 1}, B: maths.Vector2{X: 2, Y: 1}}},
+	}
+	for name, c := range touching {
+		t.Run(name, func(t *testing.T) {
+			assert.False(t, maths.Intersects(c.a, c.b))
+			assert.False(t, maths.Intersects(c.b, c.a))
+			assert.Equal(t, 0.0, maths.Distance(c.a, c.b))
+		})
+	}
+	assert.False(t, rect.Intersects(maths.Rectangle{X: 2, Y: 0, Width: 2, Height: 2}))
+	assert.False(t, maths.NewCircle(maths.Vector2{}, 1).IntersectsCircle(maths.NewCircle(maths.Vector2{X: 3}, 2)))
+
+	// a point strictly inside a polygon overlaps it
+	assert.True(t, maths.Intersects(rect, maths.Segment{A: maths.Vector2{X: 1, Y: 1}, B: maths.Vector2{X: 1, Y: 1}}))
+
+	box := maths.Box{Width: 2, Height: 2, Depth: 2}
+	sphere := maths.NewSphere(maths.Vector3{X: 3, Y: 1, Z: 1}, 1)
+	assert.False(t, box.IntersectsSphere(sphere))
+	assert.False(t, maths.Intersects3D(sphere, box))
+	assert.False(t, box.IntersectsBox(maths.Box{X: 2, Width: 2, Height: 2, Depth: 2}))
+	assert.False(t, maths.Intersects3D(sphere, maths.NewSphere(maths.Vector3{X: 5, Y: 1, Z: 1}, 1)))
+}
+
+func TestIntersects3D(t *testing.T) {
+	box := maths.Box{Width: 2, Height: 2, Depth: 2}
+	cases := map[string]struct {
+		a, b       maths.Shape3D
+		intersects bool
+		contains   bool
+	}{
+		"box vs box": {
+			a: box, b: maths.Box{X: 1, Y: 1, Z: 1, Width: 2, Height: 2, Depth: 2}, intersects: true, contains: false,
+		},
+		"box contains box": {
+			a: box, b: maths.Box{X: 0.5, Y: 0.5, Z: 0.5, Width: 1, Height: 1, Depth: 1}, intersects: true, contains: true,
+		},
+		"sphere vs box corner": {
+			a: maths.NewSphere(maths.Vector3{X: 3, Y: 3, Z: 3}, 1.7), b: box, intersects: false, contains: false,
+		},
+		"sphere vs box face": {
+			a: maths.NewSphere(maths.Vector3{X: 3, Y: 1, Z: 1}, 1.1), b: box, intersects: true, contains: false,
+		},
+		"sphere contains box": {
+			a: maths.NewSphere(maths.Vector3{X: 1, Y: 1, Z: 1}, 1.8), b: box, intersects: true, contains: true,
+		},
+		"box contains sphere": {
+			a: box, b: maths.NewSphere(maths.Vector3{X: 1, Y: 1, Z: 1}, 1), intersects: true, contains: true,
+		},
+		"sphere vs sphere": {
+			a: maths.NewSphere(maths.Vector3{}, 1), b: maths.NewSphere(maths.Vector3{X: 3}, 1), intersects: false, contains: false,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.intersects, maths.Intersects3D(c.a, c.b))
+			assert.Equal(t, c.intersects, maths.Intersects3D(c.b, c.a))
+			assert.Equal(t, c.contains, maths.Contains3D(c.a, c.b))
+		})
+	}
+}
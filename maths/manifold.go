@@ -0,0 +1,250 @@
+package maths
+
+import "math"
+
+type ContactPoint struct {
+	Point Vector2
+	Depth float64
+}
+
+// Manifold describes how two overlapping shapes touch.
+// Normal points from the first shape towards the second.
+// Shapes that only touch do not overlap, so no contact function returns a manifold for them.
+type Manifold struct {
+	Normal   Vector2
+	Contacts [2]ContactPoint
+	Count    int
+}
+
+// Depth returns the deepest penetration of the manifold.
+func (m Manifold) Depth() float64 {
+	var depth float64
+	for i := 0; i < m.Count; i++ {
+		depth = math.Max(depth, m.Contacts[i].Depth)
+	}
+	return depth
+}
+
+// Flip swaps the order of the shapes the manifold describes.
+func (m Manifold) Flip() Manifold {
+	m.Normal = m.Normal.Multiply(-1)
+	return m
+}
+
+func CircleCircleContact(a, b Circle) (Manifold, bool) {
+	d := b.Center.Sub(a.Center)
+	dist2 := d.Magnitude2()
+	radius := a.Radius + b.Radius
+	if dist2 >= radius*radius {
+		return Manifold{}, false
+	}
+
+	dist := math.Sqrt(dist2)
+	normal := Vector2{X: 1}
+	if dist > 0 {
+		normal = d.Multiply(1 / dist)
+	}
+	depth := radius - dist
+	return Manifold{
+		Normal: normal,
+		Contacts: [2]ContactPoint{{
+			Point: a.Center.Add(normal.Multiply(a.Radius - depth/2)),
+			Depth: depth,
+		}},
+		Count: 1,
+	}, true
+}
+
+func CircleRectangleContact(a Circle, b Rectangle) (Manifold, bool) {
+	return CirclePolygonContact(a, b.ToPolygon())
+}
+
+func CirclePolygonContact(a Circle, b Polygon) (Manifold, bool) {
+	vertices := counterClockwise(b.Vertices)
+	if !(Polygon{Vertices: vertices}).ContainsVec(a.Center) {
+		closest := closestOnEdges(a.Center, vertices...)
+		d := closest.Sub(a.Center)
+		dist2 := d.Magnitude2()
+		if dist2 >= a.Radius*a.Radius {
+			return Manifold{}, false
+		}
+		dist := math.Sqrt(dist2)
+		return Manifold{
+			Normal:   d.Multiply(1 / dist),
+			Contacts: [2]ContactPoint{{Point: closest, Depth: a.Radius - dist}},
+			Count:    1,
+		}, true
+	}
+
+	// the center is inside, so push out through the nearest face
+	bestDist := math.Inf(1)
+	var bestNormal Vector2
+	for i := range vertices {
+		n := edgeNormal(vertices, i)
+		dist := vertices[i].Sub(a.Center).Dot(n)
+		if dist < bestDist {
+			bestDist = dist
+			bestNormal = n
+		}
+	}
+	return Manifold{
+		Normal:   bestNormal.Multiply(-1),
+		Contacts: [2]ContactPoint{{Point: a.Center.Add(bestNormal.Multiply(bestDist)), Depth: a.Radius + bestDist}},
+		Count:    1,
+	}, true
+}
+
+func RectangleRectangleContact(a, b Rectangle) (Manifold, bool) {
+	return PolygonPolygonContact(a.ToPolygon(), b.ToPolygon())
+}
+
+// PolygonPolygonContact finds the axis of least penetration with the separating axis theorem,
+// then clips the incident edge against the reference edge to produce up to two contact points.
+func PolygonPolygonContact(a, b Polygon) (Manifold, bool) {
+	av := counterClockwise(a.Vertices)
+	bv := counterClockwise(b.Vertices)
+
+	edgeA, sepA := maxSeparation(av, bv)
+	if sepA >= 0 {
+		return Manifold{}, false
+	}
+	edgeB, sepB := maxSeparation(bv, av)
+	if sepB >= 0 {
+		return Manifold{}, false
+	}
+
+	// prefer a as the reference to avoid flip-flopping between nearly equal axes
+	ref, inc, refEdge, flip := av, bv, edgeA, false
+	if sepB > sepA+1e-6 {
+		ref, inc, refEdge, flip = bv, av, edgeB, true
+	}
+
+	normal := edgeNormal(ref, refEdge)
+
+	// the incident edge is the one facing most against the reference normal
+	incEdge := 0
+	minDot := math.Inf(1)
+	for i := range inc {
+		if d := edgeNormal(inc, i).Dot(normal); d < minDot {
+			minDot = d
+			incEdge = i
+		}
+	}
+
+	v1 := ref[refEdge]
+	v2 := ref[(refEdge+1)%len(ref)]
+	tangent := v2.Sub(v1).Normalize()
+
+	points := [2]Vector2{inc[incEdge], inc[(incEdge+1)%len(inc)]}
+	n := 2
+	points, n = clipSegment(points, n, tangent.Multiply(-1), -tangent.Dot(v1))
+	points, n = clipSegment(points, n, tangent, tangent.Dot(v2))
+
+	var m Manifold
+	offset := normal.Dot(v1)
+	for i := 0; i < n; i++ {
+		sep := normal.Dot(points[i]) - offset
+		if sep <= 0 {
+			m.Contacts[m.Count] = ContactPoint{Point: points[i], Depth: -sep}
+			m.Count++
+		}
+	}
+	if m.Count == 0 {
+		return Manifold{}, false
+	}
+
+	m.Normal = normal
+	if flip {
+		m.Normal = normal.Multiply(-1)
+	}
+	return m, true
+}
+
+// clipSegment keeps the part of the segment where normal.Dot(p) <= offset.
+func clipSegment(points [2]Vector2, n int, normal Vector2, offset float64) ([2]Vector2, int) {
+	if n < 2 {
+		return points, n
+	}
+	var out [2]Vector2
+	count := 0
+	d0 := normal.Dot(points[0]) - offset
+	d1 := normal.Dot(points[1]) - offset
+	if d0 <= 0 {
+		out[count] = points[0]
+		count++
+	}
+	if d1 <= 0 {
+		out[count] = points[1]
+		count++
+	}
+	if d0*d1 < 0 && count < 2 {
+		out[count] = points[0].Lerp(points[1], d0/(d0-d1))
+		count++
+	}
+	return out, count
+}
+
+// maxSeparation returns the edge of a whose normal separates b the most.
+func maxSeparation(a, b []Vector2) (int, float64) {
+	best := 0
+	bestSep := math.Inf(-1)
+	for i := range a {
+		n := edgeNormal(a, i)
+		sep := math.Inf(1)
+		for _, v := range b {
+			sep = math.Min(sep, n.Dot(v.Sub(a[i])))
+		}
+		if sep > bestSep {
+			bestSep = sep
+			best = i
+		}
+	}
+	return best, bestSep
+}
+
+// edgeNormal returns the outward unit normal of edge i of a counter-clockwise polygon.
+func edgeNormal(vertices []Vector2, i int) Vector2 {
+	e := vertices[(i+1)%len(vertices)].Sub(vertices[i])
+	return Vector2{X: e.Y, Y: -e.X}.Normalize()
+}
+
+// counterClockwise returns the vertices in counter-clockwise order, copying only if they need reversing.
+func counterClockwise(vertices []Vector2) []Vector2 {
+	if (Polygon{Vertices: vertices}).SignedArea() >= 0 {
+		return vertices
+	}
+	reversed := make([]Vector2, len(vertices))
+	for i, v := range vertices {
+		reversed[len(vertices)-1-i] = v
+	}
+	return reversed
+}
+
+// Manifold3D describes how two overlapping 3D shapes touch.
+// Normal points from the first shape towards the second.
+type Manifold3D struct {
+	Normal Vector3
+	Point  Vector3
+	Depth  float64
+}
+
+func SphereSphereContact(a, b Sphere) (Manifold3D, bool) {
+	d := b.Center.Sub(a.Center)
+	dist2 := d.Magnitude2()
+	radius := a.Radius + b.Radius
+	if dist2 >= radius*radius {
+		return Manifold3D{}, false
+	}
+
+	dist := math.Sqrt(dist2)
+	normal := Vector3{X: 1}
+	if dist > 0 {
+		normal = d.Multiply(1 / dist)
+	}
+	depth := radius - dist
+	return Manifold3D{
+		Normal: normal,
+		Point:  a.Center.Add(normal.Multiply(a.Radius - depth/2)),
+		Depth:  depth,
+	}, true
+}
@@ -0,0 +1,188 @@
+package maths_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func assertVector2InDelta(t *testing.T, expected, actual maths.Vector2, delta float64) {
+	t.Helper()
+	assert.True(t, expected.ApproxEqual(actual, delta), "expected %v, got %v", expected, actual)
+}
+
+func TestCircleCircleContact(t *testing.T) {
+	m, ok := maths.CircleCircleContact(maths.NewCircle(maths.Vector2{}, 2), maths.NewCircle(maths.Vector2{X: 3}, 2))
+	assert.True(t, ok)
+	assert.Equal(t, maths.Vector2{X: 1}, m.Normal)
+	assert.Equal(t, 1, m.Count)
+	assert.Equal(t, 1.0, m.Depth())
+	assert.Equal(t, maths.Vector2{X: 1.5}, m.Contacts[0].Point)
+
+	_, ok = maths.CircleCircleContact(maths.NewCircle(maths.Vector2{}, 1), maths.NewCircle(maths.Vector2{X: 3}, 1))
+	assert.False(t, ok)
+	_, ok = maths.CircleCircleContact(maths.NewCircle(maths.Vector2{}, 1), maths.NewCircle(maths.Vector2{X: 2}, 1))
+	assert.False(t, ok, "touching circles do not collide")
+
+	m, ok = maths.CircleCircleContact(maths.NewCircle(maths.Vector2{}, 1), maths.NewCircle(maths.Vector2{}, 1))
+	assert.True(t, ok)
+	assert.Equal(t, 2.0, m.Depth())
+	assert.Equal(t, 1.0, m.Normal.Magnitude())
+}
+
+func TestCircleRectangleContact(t *testing.T) {
+	rect := maths.Rectangle{X: 0, Y: 0, Width: 4, Height: 2}
+
+	t.Run("outside", func(t *testing.T) {
+		m, ok := maths.CircleRectangleContact(maths.NewCircle(maths.Vector2{X: 2, Y: 3}, 1.5), rect)
+		assert.True(t, ok)
+		assertVector2InDelta(t, maths.Vector2{Y: -1}, m.Normal, 1e-9)
+		assert.InDelta(t, 0.5, m.Depth(), 1e-9)
+		assertVector2InDelta(t, maths.Vector2{X: 2, Y: 2}, m.Contacts[0].Point, 1e-9)
+	})
+
+	t.Run("center inside", func(t *testing.T) {
+		m, ok := maths.CircleRectangleContact(maths.NewCircle(maths.Vector2{X: 3.5, Y: 1}, 1), rect)
+		assert.True(t, ok)
+		assertVector2InDelta(t, maths.Vector2{X: -1}, m.Normal, 1e-9)
+		assert.InDelta(t, 1.5, m.Depth(), 1e-9)
+	})
+
+	t.Run("separated", func(t *testing.T) {
+		_, ok := maths.CircleRectangleContact(maths.NewCircle(maths.Vector2{X: 5, Y: 3}, 1), rect)
+		assert.False(t, ok)
+	})
+}
+
+func TestRectangleRectangleContact(t *testing.T) {
+	a := maths.Rectangle{X: 0, Y: 0, Width: 4, Height: 4}
+	b := maths.Rectangle{X: 3, Y: 1, Width: 4, Height: 2}
+
+	m, ok := maths.RectangleRectangleContact(a, b)
+	assert.True(t, ok)
+	assertVector2InDelta(t, maths.Vector2{X: 1}, m.Normal, 1e-9)
+	assert.Equal(t, 2, m.Count)
+	assert.InDelta(t, 1, m.Depth(), 1e-9)
+	assert.ElementsMatch(t,
+		[]maths.Vector2{{X: 3, Y: 1}, {X: 3, Y: 3}},
+		[]maths.Vector2{m.Contacts[0].Point, m.Contacts[1].Point},
+	)
+
+	m, ok = maths.RectangleRectangleContact(b, a)
+	assert.True(t, ok)
+	assertVector2InDelta(t, maths.Vector2{X: -1}, m.Normal, 1e-9)
+
+	_, ok = maths.RectangleRectangleContact(a, maths.Rectangle{X: 5, Y: 0, Width: 1, Height: 1})
+	assert.False(t, ok)
+	_, ok = maths.RectangleRectangleContact(a, maths.Rectangle{X: 4, Y: 1, Width: 1, Height: 1})
+	assert.False(t, ok, "touching rectangles do not collide")
+}
+
+func TestPolygonPolygonContact(t *testing.T) {
+	box := maths.Rectangle{X: -1, Y: -1, Width: 2, Height: 2}.ToPolygon()
+
+	// a diamond resting point first on top of the box
+	diamond := maths.OrientedRect{Center: maths.Vector2{Y: 1 + math.Sqrt2 - 0.1}, HalfExtents: maths.Vector2{X: 1, Y: 1}, Rotation: math.Pi / 4}.ToPolygon()
+	m, ok := maths.PolygonPolygonContact(box, diamond)
+	assert.True(t, ok)
+	assertVector2InDelta(t, maths.Vector2{Y: 1}, m.Normal, 1e-9)
+	assert.Equal(t, 1, m.Count)
+	assert.InDelta(t, 0.1, m.Depth(), 1e-9)
+	assertVector2InDelta(t, maths.Vector2{Y: 0.9}, m.Contacts[0].Point, 1e-9)
+
+	// clockwise input is handled
+	reversed := maths.Polygon{Vertices: []maths.Vector2{box.Vertices[3], box.Vertices[2], box.Vertices[1], box.Vertices[0]}}
+	m2, ok := maths.PolygonPolygonContact(reversed, diamond)
+	assert.True(t, ok)
+	assertVector2InDelta(t, m.Normal, m2.Normal, 1e-9)
+}
+
+func TestSphereSphereContact(t *testing.T) {
+	m, ok := maths.SphereSphereContact(maths.NewSphere(maths.Vector3{}, 1), maths.NewSphere(maths.Vector3{Z: 1.5}, 1))
+	assert.True(t, ok)
+	assert.Equal(t, maths.Vector3{Z: 1}, m.Normal)
+	assert.Equal(t, 0.5, m.Depth)
+	assert.Equal(t, maths.Vector3{Z: 0.75}, m.Point)
+
+	_, ok = maths.SphereSphereContact(maths.NewSphere(maths.Vector3{}, 1), maths.NewSphere(maths.Vector3{Z: 2}, 1))
+	assert.False(t, ok)
+}
+
+func TestConvexContact(t *testing.T) {
+	cases := map[string]struct {
+		a, b   maths.Shape
+		normal maths.Vector2
+		depth  float64
+	}{
+		"rect vs rect": {
+			a:      maths.Rectangle{X: 0, Y: 0, Width: 4, Height: 4},
+			b:      maths.Rectangle{X: 3, Y: 1, Width: 4, Height: 2},
+			normal: maths.Vector2{X: 1},
+			depth:  1,
+		},
+		"circle vs circle": {
+			a:      maths.NewCircle(maths.Vector2{}, 2),
+			b:      maths.NewCircle(maths.Vector2{Y: 3}, 2),
+			normal: maths.Vector2{Y: 1},
+			depth:  1,
+		},
+		"triangle vs capsule": {
+			a:      maths.Triangle{A: maths.Vector2{X: -2}, B: maths.Vector2{X: 2}, C: maths.Vector2{Y: 2}},
+			b:      maths.Capsule{A: maths.Vector2{X: -5, Y: -0.5}, B: maths.Vector2{X: 5, Y: -0.5}, Radius: 1},
+			normal: maths.Vector2{Y: -1},
+			depth:  0.5,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.True(t, maths.GJKIntersects(c.a, c.b))
+			m, ok := maths.ConvexContact(c.a, c.b)
+			assert.True(t, ok)
+			assertVector2InDelta(t, c.normal, m.Normal, 1e-3)
+			assert.InDelta(t, c.depth, m.Depth(), 1e-3)
+		})
+	}
+
+	// shapes without vertices never collide
+	empty := maths.Polygon{}
+	assert.Equal(t, maths.Vector2{}, maths.Support(empty, maths.Vector2{X: 1}))
+	assert.False(t, maths.GJKIntersects(empty, maths.Rectangle{Width: 2, Height: 2}))
+	_, ok := maths.ConvexContact(maths.NewCircle(maths.Vector2{}, 1), empty)
+	assert.False(t, ok)
+
+	// touching shapes do not collide, the same as with the exact contact tests
+	box := maths.Rectangle{Width: 2, Height: 2}
+	assert.False(t, maths.GJKIntersects(box, maths.Rectangle{X: 2, Width: 2, Height: 2}))
+	_, ok = maths.ConvexContact(box, maths.Rectangle{X: 2, Width: 2, Height: 2})
+	assert.False(t, ok)
+	assert.False(t, maths.GJKIntersects(maths.NewCircle(maths.Vector2{}, 1), maths.NewCircle(maths.Vector2{X: 2}, 1)))
+
+	apart := maths.NewCircle(maths.Vector2{X: 10}, 1)
+	assert.False(t, maths.GJKIntersects(maths.Rectangle{Width: 2, Height: 2}, apart))
+	_, ok = maths.ConvexContact(maths.Rectangle{Width: 2, Height: 2}, apart)
+	assert.False(t, ok)
+
+	// GJK agrees with the exact intersection tests
+	shapes := []maths.Shape{
+		maths.Rectangle{X: 0, Y: 0, Width: 2, Height: 2},
+		maths.NewCircle(maths.Vector2{X: 3, Y: 3}, 1.2),
+		maths.NewCircle(maths.Vector2{X: 3, Y: 1}, 1.5),
+		maths.Triangle{A: maths.Vector2{X: 3}, B: maths.Vector2{X: 5}, C: maths.Vector2{X: 3, Y: 2}},
+		maths.OrientedRect{Center: maths.Vector2{X: 3, Y: 3}, HalfExtents: maths.Vector2{X: 1, Y: 1}, Rotation: math.Pi / 4},
+		maths.Capsule{A: maths.Vector2{X: -3, Y: 3}, B: maths.Vector2{X: 3, Y: -3}, Radius: 0.5},
+		// touching the first rectangle along an edge, at a corner and at a point of the circle
+		maths.Rectangle{X: 2, Y: 0.5, Width: 1, Height: 1},
+		maths.Rectangle{X: -1, Y: 2, Width: 1, Height: 1},
+		maths.NewCircle(maths.Vector2{X: 1, Y: -1}, 1),
+	}
+	for i := range shapes {
+		for j := range shapes {
+			if i == j {
+				continue
+			}
+			assert.Equal(t, maths.Intersects(shapes[i], shapes[j]), maths.GJKIntersects(shapes[i], shapes[j]), "%d vs %d", i, j)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package maths
+
+import "math"
+
+// Matrix4 is a 4x4 matrix stored in row-major order.
+// Vectors are treated as columns, so transforms compose right to left.
+type Matrix4 [16]float64
+
+func IdentityMatrix4() Matrix4 {
+	return Matrix4{
+		1, 0, 0, 0,
+		0, 1, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
+	}
+}
+
+func NewTranslationMatrix4(v Vector3) Matrix4 {
+	return Matrix4{
+		1, 0, 0, v.X,
+		0, 1, 0, v.Y,
+		0, 0, 1, v.Z,
+		0, 0, 0, 1,
+	}
+}
+
+func NewScaleMatrix4(v Vector3) Matrix4 {
+	return Matrix4{
+		v.X, 0, 0, 0,
+		0, v.Y, 0, 0,
+		0, 0, v.Z, 0,
+		0, 0, 0, 1,
+	}
+}
+
+func (m Matrix4) At(row, col int) float64 {
+	return m[row*4+col]
+}
+
+func (m Matrix4) Multiply(m2 Matrix4) Matrix4 {
+	var out Matrix4
+	for row := 0; row < 4; row++ {
+		for col := 0; col < 4; col++ {
+			var sum float64
+			for i := 0; i < 4; i++ {
+				sum += m[row*4+i] * m2[i*4+col]
+			}
+			out[row*4+col] = sum
+		}
+	}
+	return out
+}
+
+// MultiplyPoint transforms v as a position, applying translation.
+func (m Matrix4) MultiplyPoint(v Vector3) Vector3 {
+	return Vector3{
+		X: m[0]*v.X + m[1]*v.Y + m[2]*v.Z + m[3],
+		Y: m[4]*v.X + m[5]*v.Y + m[6]*v.Z + m[7],
+		Z: m[8]*v.X + m[9]*v.Y + m[10]*v.Z + m[11],
+	}
+}
+
+// MultiplyDirection transforms v as a direction, ignoring translation.
+func (m Matrix4) MultiplyDirection(v Vector3) Vector3 {
+	return Vector3{
+		X: m[0]*v.X + m[1]*v.Y + m[2]*v.Z,
+		Y: m[4]*v.X + m[5]*v.Y + m[6]*v.Z,
+		Z: m[8]*v.X + m[9]*v.Y + m[10]*v.Z,
+	}
+}
+
+func (m Matrix4) Transpose() Matrix4 {
+	var out Matrix4
+	for row := 0; row < 4; row++ {
+		for col := 0; col < 4; col++ {
+			out[col*4+row] = m[row*4+col]
+		}
+	}
+	return out
+}
+
+// Matrix3 is a 3x3 matrix stored in row-major order, used for 2D affine transforms.
+// Vectors are treated as columns, so transforms compose right to left.
+type Matrix3 [9]float64
+
+func IdentityMatrix3() Matrix3 {
+	return Matrix3{
+		1, 0, 0,
+		0, 1, 0,
+		0, 0, 1,
+	}
+}
+
+func NewTranslationMatrix3(v Vector2) Matrix3 {
+	return Matrix3{
+		1, 0, v.X,
+		0, 1, v.Y,
+		0, 0, 1,
+	}
+}
+
+// NewRotationMatrix3 creates a counter-clockwise rotation of angle radians.
+func NewRotationMatrix3(angle float64) Matrix3 {
+	sin, cos := math.Sincos(angle)
+	return Matrix3{
+		cos, -sin, 0,
+		sin, cos, 0,
+		0, 0, 1,
+	}
+}
+
+func NewScaleMatrix3(v Vector2) Matrix3 {
+	return Matrix3{
+		v.X, 0, 0,
+		0, v.Y, 0,
+		0, 0, 1,
+	}
+}
+
+func (m Matrix3) At(row, col int) float64 {
+	return m[row*3+col]
+}
+
+func (m Matrix3) Multiply(m2 Matrix3) Matrix3 {
+	var out Matrix3
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 3; col++ {
+			var sum float64
+			for i := 0; i < 3; i++ {
+				sum += m[row*3+i] * m2[i*3+col]
+			}
+			out[row*3+col] = sum
+		}
+	}
+	return out
+}
+
+// MultiplyPoint transforms v as a position, applying translation.
+func (m Matrix3) MultiplyPoint(v Vector2) Vector2 {
+	return Vector2{
+		X: m[0]*v.X + m[1]*v.Y + m[2],
+		Y: m[3]*v.X + m[4]*v.Y + m[5],
+	}
+}
+
+// MultiplyDirection transforms v as a direction, ignoring translation.
+func (m Matrix3) MultiplyDirection(v Vector2) Vector2 {
+	return Vector2{
+		X: m[0]*v.X + m[1]*v.Y,
+		Y: m[3]*v.X + m[4]*v.Y,
+	}
+}
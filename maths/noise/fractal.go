@@ -0,0 +1,127 @@
+package noise
+
+// Sampler2 is a 2D noise function such as Noise.Perlin2 or Noise.OpenSimplex2.
+type Sampler2 func(x, y float64) float64
+
+// Sampler3 is a 3D noise function such as Noise.Perlin3 or Noise.OpenSimplex3.
+type Sampler3 func(x, y, z float64) float64
+
+// Fractal sums octaves of noise. Each octave multiplies the frequency by Lacunarity and the
+// amplitude by Gain.
+type Fractal struct {
+	Octaves    int
+	Lacunarity float64
+	Gain       float64
+}
+
+func NewFractal(octaves int) Fractal {
+	return Fractal{
+		Octaves:    octaves,
+		Lacunarity: 2,
+		Gain:       0.5,
+	}
+}
+
+// FBm2 returns fractal Brownian motion, normalised to the range of the sampler.
+func (f Fractal) FBm2(sample Sampler2, x, y float64) float64 {
+	var sum, norm float64
+	freq, amp := 1.0, 1.0
+	for o := 0; o < f.Octaves; o++ {
+		sum += float64(amp * sample(x*freq, y*freq))
+		norm += amp
+		freq *= f.Lacunarity
+		amp *= f.Gain
+	}
+	return normalise(sum, norm)
+}
+
+// FBm3 returns fractal Brownian motion, normalised to the range of the sampler.
+func (f Fractal) FBm3(sample Sampler3, x, y, z float64) float64 {
+	var sum, norm float64
+	freq, amp := 1.0, 1.0
+	for o := 0; o < f.Octaves; o++ {
+		sum += float64(amp * sample(x*freq, y*freq, z*freq))
+		norm += amp
+		freq *= f.Lacunarity
+		amp *= f.Gain
+	}
+	return normalise(sum, norm)
+}
+
+// Ridged2 returns ridged multifractal noise between 0 and 1, with sharp crests where the sampler
+// crosses zero. Useful for mountain ranges and veins.
+func (f Fractal) Ridged2(sample Sampler2, x, y float64) float64 {
+	var sum, norm float64
+	freq, amp := 1.0, 1.0
+	for o := 0; o < f.Octaves; o++ {
+		sum += float64(amp * ridge(sample(x*freq, y*freq)))
+		norm += amp
+		freq *= f.Lacunarity
+		amp *= f.Gain
+	}
+	return normalise(sum, norm)
+}
+
+// Ridged3 returns ridged multifractal noise between 0 and 1.
+func (f Fractal) Ridged3(sample Sampler3, x, y, z float64) float64 {
+	var sum, norm float64
+	freq, amp := 1.0, 1.0
+	for o := 0; o < f.Octaves; o++ {
+		sum += float64(amp * ridge(sample(x*freq, y*freq, z*freq)))
+		norm += amp
+		freq *= f.Lacunarity
+		amp *= f.Gain
+	}
+	return normalise(sum, norm)
+}
+
+// FBm2Tiled returns fractal Brownian motion of Perlin noise that repeats every periodX and
+// periodY units. Lacunarity is rounded to a whole number so every octave tiles.
+func (f Fractal) FBm2Tiled(n *Noise, x, y float64, periodX, periodY int) float64 {
+	lacunarity := int(f.Lacunarity + 0.5)
+	if lacunarity < 1 {
+		lacunarity = 1
+	}
+
+	var sum, norm float64
+	freq, amp := 1, 1.0
+	for o := 0; o < f.Octaves; o++ {
+		scale := float64(freq)
+		sum += float64(amp * n.Perlin2Tiled(x*scale, y*scale, periodX*freq, periodY*freq))
+		norm += amp
+		freq *= lacunarity
+		amp *= f.Gain
+	}
+	return normalise(sum, norm)
+}
+
+// DomainWarp2 samples at a position displaced by warp, which gives swirling, folded patterns.
+// The displacement on each axis is read from a different region of warp.
+func DomainWarp2(sample, warp Sampler2, x, y, strength float64) float64 {
+	wx := float64(strength * warp(x, y))
+	wy := float64(strength * warp(x+5.2, y+1.3))
+	return sample(x+wx, y+wy)
+}
+
+// DomainWarp3 samples at a position displaced by warp.
+func DomainWarp3(sample, warp Sampler3, x, y, z, strength float64) float64 {
+	wx := float64(strength * warp(x, y, z))
+	wy := float64(strength * warp(x+5.2, y+1.3, z+2.8))
+	wz := float64(strength * warp(x+1.7, y+9.2, z+4.6))
+	return sample(x+wx, y+wy, z+wz)
+}
+
+func ridge(v float64) float64 {
+	if v < 0 {
+		v = -v
+	}
+	v = 1 - v
+	return v * v
+}
+
+func normalise(sum, norm float64) float64 {
+	if norm == 0 {
+		return 0
+	}
+	return sum / norm
+}
@@ -0,0 +1,84 @@
+// Package noise generates seeded coherent noise for procedural content.
+//
+// The same seed gives the same values on every platform. Permutations come from a fixed integer
+// generator rather than math/rand, and every product that feeds an addition is rounded with an
+// explicit float64 conversion so the compiler cannot fuse it into a multiply-add instruction on
+// architectures that have one.
+package noise
+
+import "math"
+
+// Noise holds the permutation table for a seed. It is safe to sample from multiple goroutines.
+type Noise struct {
+	perm [512]uint8
+}
+
+func New(seed int64) *Noise {
+	n := &Noise{}
+	for i := 0; i < 256; i++ {
+		n.perm[i] = uint8(i)
+	}
+
+	// Fisher-Yates shuffle driven by splitmix64
+	state := uint64(seed)
+	for i := 255; i > 0; i-- {
+		state += 0x9e3779b97f4a7c15
+		z := state
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		z ^= z >> 31
+		j := int(z % uint64(i+1))
+		n.perm[i], n.perm[j] = n.perm[j], n.perm[i]
+	}
+	for i := 0; i < 256; i++ {
+		n.perm[i+256] = n.perm[i]
+	}
+	return n
+}
+
+func (n *Noise) hash2(x, y int) int {
+	return int(n.perm[int(n.perm[x&255])+y&255])
+}
+
+func (n *Noise) hash3(x, y, z int) int {
+	return int(n.perm[int(n.perm[int(n.perm[x&255])+y&255])+z&255])
+}
+
+func (n *Noise) hash4(x, y, z, w int) int {
+	return int(n.perm[int(n.perm[int(n.perm[int(n.perm[x&255])+y&255])+z&255])+w&255])
+}
+
+func floor(x float64) int {
+	return int(math.Floor(x))
+}
+
+// fade is the quintic 6t^5 - 15t^4 + 10t^3, which has zero first and second derivatives at 0 and 1.
+func fade(t float64) float64 {
+	t3 := float64(float64(t*t) * t)
+	return float64(t3 * (float64(t*(float64(t*6)-15)) + 10))
+}
+
+func lerp(a, b, t float64) float64 {
+	return a + float64(t*(b-a))
+}
+
+func dot2(gx, gy, x, y float64) float64 {
+	return float64(gx*x) + float64(gy*y)
+}
+
+func dot3(gx, gy, gz, x, y, z float64) float64 {
+	return float64(gx*x) + float64(gy*y) + float64(gz*z)
+}
+
+func dot4(gx, gy, gz, gw, x, y, z, w float64) float64 {
+	return float64(gx*x) + float64(gy*y) + float64(gz*z) + float64(gw*w)
+}
+
+// wrap returns i modulo a positive period.
+func wrap(i, period int) int {
+	i %= period
+	if i < 0 {
+		i += period
+	}
+	return i
+}
@@ -0,0 +1,145 @@
+package noise_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/soupstoregames/gamelib/maths/noise"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoise_Deterministic(t *testing.T) {
+	a, b, c := noise.New(42), noise.New(42), noise.New(43)
+
+	same, different := 0, 0
+	for i := 0; i < 100; i++ {
+		x, y, z, w := float64(i)*0.37, float64(i)*0.91, float64(i)*0.13, float64(i)*0.29
+		assert.Equal(t, a.Perlin3(x, y, z), b.Perlin3(x, y, z))
+		assert.Equal(t, a.OpenSimplex4(x, y, z, w), b.OpenSimplex4(x, y, z, w))
+		if a.OpenSimplex2(x, y) == c.OpenSimplex2(x, y) {
+			same++
+		} else {
+			different++
+		}
+	}
+	assert.Greater(t, different, same)
+}
+
+func TestNoise_Golden(t *testing.T) {
+	// fixed outputs guard against changes to the permutation or arithmetic
+	n := noise.New(1234)
+	cases := map[string]struct {
+		actual, expected float64
+	}{
+		"perlin2":      {n.Perlin2(1.3, 2.7), -0.16874996543999987},
+		"perlin3":      {n.Perlin3(1.3, 2.7, -0.4), -0.20520690130391039},
+		"perlin4":      {n.Perlin4(1.3, 2.7, -0.4, 5.1), -0.16486201320876184},
+		"opensimplex2": {n.OpenSimplex2(1.3, 2.7), 0.13004689322596702},
+		"opensimplex3": {n.OpenSimplex3(1.3, 2.7, -0.4), -0.60869258799999926},
+		"opensimplex4": {n.OpenSimplex4(1.3, 2.7, -0.4, 5.1), -0.24331007498394289},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expected, c.actual)
+		})
+	}
+}
+
+func TestNoise_Range(t *testing.T) {
+	n := noise.New(7)
+	samplers := map[string]func(x, y, z, w float64) float64{
+		"perlin2":      func(x, y, z, w float64) float64 { return n.Perlin2(x, y) },
+		"perlin3":      func(x, y, z, w float64) float64 { return n.Perlin3(x, y, z) },
+		"perlin4":      n.Perlin4,
+		"opensimplex2": func(x, y, z, w float64) float64 { return n.OpenSimplex2(x, y) },
+		"opensimplex3": func(x, y, z, w float64) float64 { return n.OpenSimplex3(x, y, z) },
+		"opensimplex4": n.OpenSimplex4,
+	}
+	for name, sample := range samplers {
+		t.Run(name, func(t *testing.T) {
+			low, high := math.Inf(1), math.Inf(-1)
+			for i := 0; i < 20000; i++ {
+				f := float64(i)
+				v := sample(f*0.0731-300, f*0.1173+20, f*0.0297-5, f*0.0519)
+				low, high = math.Min(low, v), math.Max(high, v)
+
+				// continuity: a tiny step gives a tiny change
+				assert.InDelta(t, v, sample(f*0.0731-300+1e-6, f*0.1173+20, f*0.0297-5, f*0.0519), 1e-4)
+			}
+			assert.GreaterOrEqual(t, low, -1.1)
+			assert.LessOrEqual(t, high, 1.1)
+			assert.Less(t, low, -0.3)
+			assert.Greater(t, high, 0.3)
+		})
+	}
+}
+
+func TestNoise_PerlinLattice(t *testing.T) {
+	n := noise.New(3)
+	for i := -5; i <= 5; i++ {
+		f := float64(i)
+		assert.Equal(t, 0.0, n.Perlin2(f, -f))
+		assert.Equal(t, 0.0, n.Perlin3(f, 2*f, 3))
+		assert.Equal(t, 0.0, n.Perlin4(f, 1, -f, 2))
+	}
+}
+
+func TestNoise_Tiled(t *testing.T) {
+	n := noise.New(11)
+	f := noise.NewFractal(4)
+	for i := 0; i < 50; i++ {
+		x, y, z := float64(i)*0.173, float64(i)*0.311, float64(i)*0.057
+		assert.InDelta(t, n.Perlin2Tiled(x, y, 4, 6), n.Perlin2Tiled(x+4, y-6, 4, 6), 1e-12)
+		assert.InDelta(t, n.Perlin3Tiled(x, y, z, 3, 5, 7), n.Perlin3Tiled(x-3, y+10, z+7, 3, 5, 7), 1e-12)
+		assert.InDelta(t, f.FBm2Tiled(n, x, y, 8, 8), f.FBm2Tiled(n, x+8, y+16, 8, 8), 1e-12)
+	}
+}
+
+func TestNoise_Worley(t *testing.T) {
+	n := noise.New(5)
+	for i := 0; i < 1000; i++ {
+		x, y, z := float64(i)*0.0713-20, float64(i)*0.0291+3, float64(i)*0.053
+		f1, f2 := n.Worley2(x, y)
+		assert.GreaterOrEqual(t, f1, 0.0)
+		assert.LessOrEqual(t, f1, math.Sqrt2)
+		assert.LessOrEqual(t, f1, f2)
+
+		f1, f2 = n.Worley3(x, y, z)
+		assert.GreaterOrEqual(t, f1, 0.0)
+		assert.LessOrEqual(t, f1, math.Sqrt(3))
+		assert.LessOrEqual(t, f1, f2)
+	}
+}
+
+func TestFractal(t *testing.T) {
+	n := noise.New(9)
+	f := noise.NewFractal(5)
+	warp := noise.NewFractal(2)
+	for i := 0; i < 1000; i++ {
+		x, y, z := float64(i)*0.0713, float64(i)*0.0291, float64(i)*0.053
+
+		v := f.FBm2(n.OpenSimplex2, x, y)
+		assert.GreaterOrEqual(t, v, -1.1)
+		assert.LessOrEqual(t, v, 1.1)
+
+		v = f.FBm3(n.Perlin3, x, y, z)
+		assert.GreaterOrEqual(t, v, -1.1)
+		assert.LessOrEqual(t, v, 1.1)
+
+		v = f.Ridged2(n.Perlin2, x, y)
+		assert.GreaterOrEqual(t, v, 0.0)
+		assert.LessOrEqual(t, v, 1.0)
+
+		v = f.Ridged3(n.OpenSimplex3, x, y, z)
+		assert.GreaterOrEqual(t, v, 0.0)
+		assert.LessOrEqual(t, v, 1.0)
+
+		v = noise.DomainWarp2(n.OpenSimplex2, func(x, y float64) float64 { return warp.FBm2(n.Perlin2, x, y) }, x, y, 2)
+		assert.GreaterOrEqual(t, v, -1.1)
+		assert.LessOrEqual(t, v, 1.1)
+	}
+
+	// a single octave is the sampler itself
+	assert.Equal(t, n.Perlin2(0.3, 0.7), noise.NewFractal(1).FBm2(n.Perlin2, 0.3, 0.7))
+	assert.Equal(t, 0.0, noise.NewFractal(0).FBm2(n.Perlin2, 0.3, 0.7))
+}
@@ -0,0 +1,188 @@
+package noise
+
+// OpenSimplex2 noise, after K.jpg's OpenSimplex2 (the faster of its two variants). Each sample
+// touches only the lattice points within a fixed radius, so it shows fewer axis-aligned artifacts
+// than Perlin noise. 2D uses the triangular simplex lattice. 3D rotates the input and sums two
+// offset cubic lattices that together form a body-centred cubic lattice. 4D steps through five
+// offset copies of the 4D simplex lattice. The 3D and 4D kernels use a squared radius of 0.5
+// rather than the reference's 0.6, which is the most that keeps every lattice point in range among
+// the ones visited, so the noise has no small jumps.
+
+const (
+	skew2   = 0.366025403784439  // (sqrt(3) - 1) / 2
+	unskew2 = -0.211324865405187 // (1 / sqrt(3) - 1) / 2
+	skew4   = -0.138196601125011 // (1 / sqrt(5) - 1) / 4
+	unskew4 = 0.309016994374947  // (sqrt(5) - 1) / 4
+	step4   = 0.2
+)
+
+// OpenSimplex2 returns 2D OpenSimplex2 noise, roughly between -1 and 1.
+func (n *Noise) OpenSimplex2(x, y float64) float64 {
+	s := float64(skew2 * (x + y))
+	xs, ys := x+s, y+s
+	i, j := floor(xs), floor(ys)
+	xi, yi := xs-float64(i), ys-float64(j)
+
+	// offsets to the base corner in unskewed space
+	t := float64((xi + yi) * unskew2)
+	dx0, dy0 := xi+t, yi+t
+
+	corner := func(ix, iy int, dx, dy float64) float64 {
+		a := 0.5 - float64(dx*dx) - float64(dy*dy)
+		if a <= 0 {
+			return 0
+		}
+		g := grad2[n.hash2(ix, iy)&7]
+		a *= a
+		return float64(float64(a*a) * dot2(g[0], g[1], dx, dy))
+	}
+
+	// the base and opposite corners always, then the third corner of the triangle
+	value := corner(i, j, dx0, dy0) + corner(i+1, j+1, dx0-(1+2*unskew2), dy0-(1+2*unskew2))
+	if dy0 > dx0 {
+		value += corner(i, j+1, dx0-unskew2, dy0-(1+unskew2))
+	} else {
+		value += corner(i+1, j, dx0-(1+unskew2), dy0-unskew2)
+	}
+	return value * 70
+}
+
+// OpenSimplex3 returns 3D OpenSimplex2 noise, roughly between -1 and 1.
+func (n *Noise) OpenSimplex3(x, y, z float64) float64 {
+	// rotate so that the lattice's main diagonal does not line up with an axis
+	r := float64((x + y + z) * (2.0 / 3))
+	xr, yr, zr := r-x, r-y, r-z
+
+	// the nearest point of the first cubic lattice
+	i, j, k := round(xr), round(yr), round(zr)
+	xri, yri, zri := xr-float64(i), yr-float64(j), zr-float64(k)
+
+	// the direction from each offset back towards the lattice point
+	signX, signY, signZ := towards(xri), towards(yri), towards(zri)
+	ax, ay, az := float64(-signX)*xri, float64(-signY)*yri, float64(-signZ)*zri
+
+	var value float64
+	for lattice := 0; ; lattice++ {
+		a := 0.5 - float64(xri*xri) - float64(yri*yri) - float64(zri*zri)
+		if a > 0 {
+			value += n.openSimplex3Corner(i, j, k, lattice, a, xri, yri, zri)
+		}
+
+		// the next closest point on this lattice is one step along the largest offset
+		switch {
+		case ax >= ay && ax >= az:
+			if b := a + 2*ax - 1; b > 0 {
+				value += n.openSimplex3Corner(i-signX, j, k, lattice, b, xri+float64(signX), yri, zri)
+			}
+		case ay > ax && ay >= az:
+			if b := a + 2*ay - 1; b > 0 {
+				value += n.openSimplex3Corner(i, j-signY, k, lattice, b, xri, yri+float64(signY), zri)
+			}
+		default:
+			if b := a + 2*az - 1; b > 0 {
+				value += n.openSimplex3Corner(i, j, k-signZ, lattice, b, xri, yri, zri+float64(signZ))
+			}
+		}
+		if lattice == 1 {
+			break
+		}
+
+		// move to the nearest point of the second lattice, offset by half a cell on every axis,
+		// indexing its points by the corner half a cell below
+		ax, ay, az = 0.5-ax, 0.5-ay, 0.5-az
+		xri, yri, zri = float64(signX)*ax, float64(signY)*ay, float64(signZ)*az
+		if signX < 0 {
+			i++
+		}
+		if signY < 0 {
+			j++
+		}
+		if signZ < 0 {
+			k++
+		}
+		signX, signY, signZ = -signX, -signY, -signZ
+	}
+	return value * 76
+}
+
+func (n *Noise) openSimplex3Corner(i, j, k, lattice int, a, dx, dy, dz float64) float64 {
+	g := grad3[int(n.perm[n.hash3(i, j, k)+lattice])&15]
+	a *= a
+	return float64(float64(a*a) * dot3(g[0], g[1], g[2], dx, dy, dz))
+}
+
+// OpenSimplex4 returns 4D OpenSimplex2 noise, roughly between -1 and 1.
+func (n *Noise) OpenSimplex4(x, y, z, w float64) float64 {
+	s := float64(skew4 * (x + y + z + w))
+	xs, ys, zs, ws := x+s, y+s, z+s, w+s
+	cell := [4]int{floor(xs), floor(ys), floor(zs), floor(ws)}
+	si := [4]float64{xs - float64(cell[0]), ys - float64(cell[1]), zs - float64(cell[2]), ws - float64(cell[3])}
+
+	// start on the offset lattice closest to the point, stepping back through the others
+	sum := si[0] + si[1] + si[2] + si[3]
+	start := int(float64(sum * 1.25))
+	offset := float64(float64(start) * -step4)
+	for a := range si {
+		si[a] += offset
+	}
+	ssi := float64((sum + float64(offset*4)) * unskew4)
+
+	var value float64
+	for l := 0; ; l++ {
+		// the nearest point in this copy is the base corner or one step along the largest offset,
+		// which then serves as the base for the copies after it
+		score := 1 + float64(ssi*(-1/unskew4))
+		if axis := largest(si); si[axis] >= score {
+			cell[axis]++
+			si[axis]--
+			ssi -= unskew4
+		}
+
+		dx, dy, dz, dw := si[0]+ssi, si[1]+ssi, si[2]+ssi, si[3]+ssi
+		if a := float64(dx*dx) + float64(dy*dy) + float64(dz*dz) + float64(dw*dw); a < 0.5 {
+			lattice := wrap(start-l, 5)
+			g := grad4[int(n.perm[n.hash4(cell[0], cell[1], cell[2], cell[3])+lattice])&31]
+			a -= 0.5
+			a *= a
+			value += float64(float64(a*a) * dot4(g[0], g[1], g[2], g[3], dx, dy, dz, dw))
+		}
+		if l == 4 {
+			break
+		}
+
+		for a := range si {
+			si[a] += step4
+		}
+		ssi += step4 * 4 * unskew4
+		if l == start {
+			// the remaining copies are offset from the corner one below on every axis
+			for a := range cell {
+				cell[a]--
+			}
+		}
+	}
+	return value * 62
+}
+
+func round(x float64) int {
+	return floor(x + 0.5)
+}
+
+// towards returns -1 for a non-negative offset and 1 for a negative one.
+func towards(offset float64) int {
+	if offset >= 0 {
+		return -1
+	}
+	return 1
+}
+
+// largest returns the axis with the largest value, preferring the earliest on ties.
+func largest(v [4]float64) int {
+	axis := 0
+	for a := 1; a < 4; a++ {
+		if v[a] > v[axis] {
+			axis = a
+		}
+	}
+	return axis
+}
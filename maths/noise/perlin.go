@@ -0,0 +1,128 @@
+package noise
+
+// gradients point from the centre of a square, cube or hypercube to the middle of its edges
+var (
+	grad2 = [8][2]float64{
+		{1, 1}, {-1, 1}, {1, -1}, {-1, -1},
+		{1, 0}, {-1, 0}, {0, 1}, {0, -1},
+	}
+	grad3 = [16][3]float64{
+		{1, 1, 0}, {-1, 1, 0}, {1, -1, 0}, {-1, -1, 0},
+		{1, 0, 1}, {-1, 0, 1}, {1, 0, -1}, {-1, 0, -1},
+		{0, 1, 1}, {0, -1, 1}, {0, 1, -1}, {0, -1, -1},
+		// repeated so the table can be indexed with the low four bits of a hash
+		{1, 1, 0}, {-1, 1, 0}, {0, -1, 1}, {0, -1, -1},
+	}
+	grad4 = [32][4]float64{
+		{0, 1, 1, 1}, {0, 1, 1, -1}, {0, 1, -1, 1}, {0, 1, -1, -1},
+		{0, -1, 1, 1}, {0, -1, 1, -1}, {0, -1, -1, 1}, {0, -1, -1, -1},
+		{1, 0, 1, 1}, {1, 0, 1, -1}, {1, 0, -1, 1}, {1, 0, -1, -1},
+		{-1, 0, 1, 1}, {-1, 0, 1, -1}, {-1, 0, -1, 1}, {-1, 0, -1, -1},
+		{1, 1, 0, 1}, {1, 1, 0, -1}, {1, -1, 0, 1}, {1, -1, 0, -1},
+		{-1, 1, 0, 1}, {-1, 1, 0, -1}, {-1, -1, 0, 1}, {-1, -1, 0, -1},
+		{1, 1, 1, 0}, {1, 1, -1, 0}, {1, -1, 1, 0}, {1, -1, -1, 0},
+		{-1, 1, 1, 0}, {-1, 1, -1, 0}, {-1, -1, 1, 0}, {-1, -1, -1, 0},
+	}
+)
+
+// Perlin2 returns improved Perlin noise, roughly between -1 and 1. It is 0 at integer coordinates.
+func (n *Noise) Perlin2(x, y float64) float64 {
+	return n.perlin2(x, y, 0, 0)
+}
+
+// Perlin2Tiled returns Perlin2 noise that repeats every periodX and periodY units.
+func (n *Noise) Perlin2Tiled(x, y float64, periodX, periodY int) float64 {
+	return n.perlin2(x, y, periodX, periodY)
+}
+
+// perlin2 wraps the lattice when the periods are positive.
+func (n *Noise) perlin2(x, y float64, periodX, periodY int) float64 {
+	x0, y0 := floor(x), floor(y)
+	fx, fy := x-float64(x0), y-float64(y0)
+	x1, y1 := x0+1, y0+1
+	if periodX > 0 {
+		x0, x1 = wrap(x0, periodX), wrap(x1, periodX)
+	}
+	if periodY > 0 {
+		y0, y1 = wrap(y0, periodY), wrap(y1, periodY)
+	}
+
+	corner := func(ix, iy int, dx, dy float64) float64 {
+		g := grad2[n.hash2(ix, iy)&7]
+		return dot2(g[0], g[1], dx, dy)
+	}
+	u, v := fade(fx), fade(fy)
+	return lerp(
+		lerp(corner(x0, y0, fx, fy), corner(x1, y0, fx-1, fy), u),
+		lerp(corner(x0, y1, fx, fy-1), corner(x1, y1, fx-1, fy-1), u),
+		v,
+	)
+}
+
+// Perlin3 returns improved Perlin noise, roughly between -1 and 1. It is 0 at integer coordinates.
+func (n *Noise) Perlin3(x, y, z float64) float64 {
+	return n.perlin3(x, y, z, 0, 0, 0)
+}
+
+// Perlin3Tiled returns Perlin3 noise that repeats every periodX, periodY and periodZ units.
+func (n *Noise) Perlin3Tiled(x, y, z float64, periodX, periodY, periodZ int) float64 {
+	return n.perlin3(x, y, z, periodX, periodY, periodZ)
+}
+
+func (n *Noise) perlin3(x, y, z float64, periodX, periodY, periodZ int) float64 {
+	x0, y0, z0 := floor(x), floor(y), floor(z)
+	fx, fy, fz := x-float64(x0), y-float64(y0), z-float64(z0)
+	x1, y1, z1 := x0+1, y0+1, z0+1
+	if periodX > 0 {
+		x0, x1 = wrap(x0, periodX), wrap(x1, periodX)
+	}
+	if periodY > 0 {
+		y0, y1 = wrap(y0, periodY), wrap(y1, periodY)
+	}
+	if periodZ > 0 {
+		z0, z1 = wrap(z0, periodZ), wrap(z1, periodZ)
+	}
+
+	corner := func(ix, iy, iz int, dx, dy, dz float64) float64 {
+		g := grad3[n.hash3(ix, iy, iz)&15]
+		return dot3(g[0], g[1], g[2], dx, dy, dz)
+	}
+	u, v, w := fade(fx), fade(fy), fade(fz)
+	return lerp(
+		lerp(
+			lerp(corner(x0, y0, z0, fx, fy, fz), corner(x1, y0, z0, fx-1, fy, fz), u),
+			lerp(corner(x0, y1, z0, fx, fy-1, fz), corner(x1, y1, z0, fx-1, fy-1, fz), u),
+			v,
+		),
+		lerp(
+			lerp(corner(x0, y0, z1, fx, fy, fz-1), corner(x1, y0, z1, fx-1, fy, fz-1), u),
+			lerp(corner(x0, y1, z1, fx, fy-1, fz-1), corner(x1, y1, z1, fx-1, fy-1, fz-1), u),
+			v,
+		),
+		w,
+	)
+}
+
+// Perlin4 returns improved Perlin noise, roughly between -1 and 1. It is 0 at integer coordinates.
+// The fourth dimension is often time, to animate 3D noise smoothly.
+func (n *Noise) Perlin4(x, y, z, w float64) float64 {
+	x0, y0, z0, w0 := floor(x), floor(y), floor(z), floor(w)
+	f := [4]float64{x - float64(x0), y - float64(y0), z - float64(z0), w - float64(w0)}
+	u := [4]float64{fade(f[0]), fade(f[1]), fade(f[2]), fade(f[3])}
+
+	// interpolate the 16 corners of the hypercube one axis at a time
+	var values [16]float64
+	for c := 0; c < 16; c++ {
+		dx, dy, dz, dw := c&1, c>>1&1, c>>2&1, c>>3&1
+		g := grad4[n.hash4(x0+dx, y0+dy, z0+dz, w0+dw)&31]
+		values[c] = dot4(g[0], g[1], g[2], g[3], f[0]-float64(dx), f[1]-float64(dy), f[2]-float64(dz), f[3]-float64(dw))
+	}
+	for axis, size := 0, 16; axis < 4; axis++ {
+		size /= 2
+		for c := 0; c < size; c++ {
+			values[c] = lerp(values[2*c], values[2*c+1], u[axis])
+		}
+	}
+	// the 4D gradients peak at about 1.1, so scale back into range
+	return values[0] * 0.9
+}
@@ -0,0 +1,54 @@
+package noise
+
+import "math"
+
+// Worley2 returns cellular noise: the distances from (x, y) to the nearest and second nearest of a
+// set of feature points, one jittered point per unit cell. Only neighbouring cells are searched, so
+// as is usual for Worley noise, f2 can rarely be too large near cell corners.
+func (n *Noise) Worley2(x, y float64) (f1, f2 float64) {
+	cx, cy := floor(x), floor(y)
+	f1, f2 = math.Inf(1), math.Inf(1)
+	for j := cy - 1; j <= cy+1; j++ {
+		for i := cx - 1; i <= cx+1; i++ {
+			px := float64(i) + n.jitter(n.hash2(i, j), n.hash3(i, j, 1))
+			py := float64(j) + n.jitter(n.hash3(i, j, 2), n.hash3(i, j, 3))
+			dx, dy := px-x, py-y
+			d := float64(dx*dx) + float64(dy*dy)
+			if d < f1 {
+				f1, f2 = d, f1
+			} else if d < f2 {
+				f2 = d
+			}
+		}
+	}
+	return math.Sqrt(f1), math.Sqrt(f2)
+}
+
+// Worley3 returns cellular noise: the distances from (x, y, z) to the nearest and second nearest
+// feature points, one jittered point per unit cell.
+func (n *Noise) Worley3(x, y, z float64) (f1, f2 float64) {
+	cx, cy, cz := floor(x), floor(y), floor(z)
+	f1, f2 = math.Inf(1), math.Inf(1)
+	for k := cz - 1; k <= cz+1; k++ {
+		for j := cy - 1; j <= cy+1; j++ {
+			for i := cx - 1; i <= cx+1; i++ {
+				px := float64(i) + n.jitter(n.hash3(i, j, k), n.hash4(i, j, k, 1))
+				py := float64(j) + n.jitter(n.hash4(i, j, k, 2), n.hash4(i, j, k, 3))
+				pz := float64(k) + n.jitter(n.hash4(i, j, k, 4), n.hash4(i, j, k, 5))
+				dx, dy, dz := px-x, py-y, pz-z
+				d := float64(dx*dx) + float64(dy*dy) + float64(dz*dz)
+				if d < f1 {
+					f1, f2 = d, f1
+				} else if d < f2 {
+					f2 = d
+				}
+			}
+		}
+	}
+	return math.Sqrt(f1), math.Sqrt(f2)
+}
+
+// jitter combines two hashes into an offset in [0, 1).
+func (n *Noise) jitter(high, low int) float64 {
+	return float64(high<<8|low) / 65536
+}
@@ -0,0 +1,163 @@
+package maths
+
+import "math"
+
+// Clamp limits v to the range [min, max]. A NaN v is returned unchanged.
+func Clamp[T Number](v, min, max T) T {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// Lerp interpolates from a to b. It returns exactly a at t = 0 and b at t = 1, even when the other
+// end is infinite.
+func Lerp[T Float](a, b, t T) T {
+	if t == 0 {
+		return a
+	}
+	if t == 1 {
+		return b
+	}
+	return a + (b-a)*t
+}
+
+// InverseLerp returns how far v is from a towards b, so that Lerp(a, b, InverseLerp(a, b, v)) == v.
+// It returns 0 when a == b.
+func InverseLerp[T Float](a, b, v T) T {
+	if a == b {
+		return 0
+	}
+	return (v - a) / (b - a)
+}
+
+// Remap maps v from the range [inMin, inMax] to [outMin, outMax] without clamping.
+func Remap[T Float](v, inMin, inMax, outMin, outMax T) T {
+	return Lerp(outMin, outMax, InverseLerp(inMin, inMax, v))
+}
+
+// SmoothStep eases from 0 at edge0 to 1 at edge1 with zero slope at both ends.
+// When the edges are equal it is a step at that edge.
+func SmoothStep[T Float](edge0, edge1, x T) T {
+	if edge0 == edge1 {
+		if x < edge0 {
+			return 0
+		}
+		return 1
+	}
+	t := Clamp((x-edge0)/(edge1-edge0), 0, 1)
+	return t * t * (3 - 2*t)
+}
+
+// WrapAngle returns the equivalent angle in radians in the range (-Pi, Pi].
+// Infinite and NaN angles return NaN.
+func WrapAngle[T Float](angle T) T {
+	a := math.Mod(float64(angle)+math.Pi, 2*math.Pi)
+	if a <= 0 {
+		a += 2 * math.Pi
+	}
+	return T(a - math.Pi)
+}
+
+// AngleDifference returns the shortest signed rotation in radians from one angle to another,
+// in the range (-Pi, Pi]. It is positive when to is counter-clockwise from from.
+func AngleDifference[T Float](from, to T) T {
+	return WrapAngle(to - from)
+}
+
+// LerpAngle interpolates between angles in radians along the shortest way round.
+func LerpAngle[T Float](from, to, t T) T {
+	return WrapAngle(from + AngleDifference(from, to)*t)
+}
+
+// ApproxEqual reports whether a and b are within epsilon of each other.
+// Equal infinities are approximately equal; NaN is never approximately equal to anything.
+func ApproxEqual[T Float](a, b, epsilon T) bool {
+	if a == b {
+		return true
+	}
+	d := a - b
+	return d <= epsilon && -d <= epsilon
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of v. Zeros and NaN are returned unchanged.
+func Sign[T Number](v T) T {
+	if v > 0 {
+		return 1
+	}
+	if v < 0 {
+		return -1
+	}
+	return v
+}
+
+// Interval is the closed range [Min, Max]. It is empty when Min > Max.
+type Interval[T Number] struct {
+	Min T
+	Max T
+}
+
+func NewInterval[T Number](a, b T) Interval[T] {
+	if a > b {
+		a, b = b, a
+	}
+	return Interval[T]{Min: a, Max: b}
+}
+
+func (i Interval[T]) Empty() bool {
+	return !(i.Min <= i.Max)
+}
+
+// Length returns Max - Min, or 0 for an empty interval.
+func (i Interval[T]) Length() T {
+	if i.Empty() {
+		return 0
+	}
+	return i.Max - i.Min
+}
+
+func (i Interval[T]) Contains(v T) bool {
+	return i.Min <= v && v <= i.Max
+}
+
+func (i Interval[T]) Clamp(v T) T {
+	return Clamp(v, i.Min, i.Max)
+}
+
+// Overlaps reports whether the intervals share at least one value. Touching intervals overlap.
+func (i Interval[T]) Overlaps(i2 Interval[T]) bool {
+	return !i.Empty() && !i2.Empty() && i.Min <= i2.Max && i2.Min <= i.Max
+}
+
+// Overlap returns the length of the shared range, or the negated gap between them when they
+// do not overlap, which is how far one would have to move to touch the other.
+func (i Interval[T]) Overlap(i2 Interval[T]) T {
+	return minNumber(i.Max, i2.Max) - maxNumber(i.Min, i2.Min)
+}
+
+// Intersection returns the range shared by both intervals, or false if there is none.
+func (i Interval[T]) Intersection(i2 Interval[T]) (Interval[T], bool) {
+	if !i.Overlaps(i2) {
+		return Interval[T]{}, false
+	}
+	return Interval[T]{Min: maxNumber(i.Min, i2.Min), Max: minNumber(i.Max, i2.Max)}, true
+}
+
+// Union returns the smallest interval containing both. Empty intervals are ignored.
+func (i Interval[T]) Union(i2 Interval[T]) Interval[T] {
+	if i.Empty() {
+		return i2
+	}
+	if i2.Empty() {
+		return i
+	}
+	return Interval[T]{Min: minNumber(i.Min, i2.Min), Max: maxNumber(i.Max, i2.Max)}
+}
+
+// Expand grows the interval by amount at both ends.
+func (i Interval[T]) Expand(amount T) Interval[T] {
+	return Interval[T]{Min: i.Min - amount, Max: i.Max + amount}
+}
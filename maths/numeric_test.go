@@ -0,0 +1,138 @@
+package maths_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	nan  = math.NaN()
+	inf  = math.Inf(1)
+	ninf = math.Inf(-1)
+)
+
+func TestClamp(t *testing.T) {
+	assert.Equal(t, 5, maths.Clamp(7, 0, 5))
+	assert.Equal(t, 0, maths.Clamp(-3, 0, 5))
+	assert.Equal(t, int8(3), maths.Clamp[int8](3, 0, 5))
+	assert.Equal(t, 1.0, maths.Clamp(inf, 0, 1))
+	assert.Equal(t, 0.0, maths.Clamp(ninf, 0, 1))
+	assert.True(t, math.IsNaN(maths.Clamp(nan, 0, 1)))
+}
+
+func TestLerp(t *testing.T) {
+	assert.Equal(t, 2.5, maths.Lerp[float64](0, 10, 0.25))
+	assert.Equal(t, float32(0.3), maths.Lerp[float32](0.1, 0.3, 1))
+	assert.Equal(t, 0.0, maths.Lerp[float64](0, inf, 0))
+	assert.Equal(t, inf, maths.Lerp[float64](0, inf, 1))
+	assert.Equal(t, inf, maths.Lerp[float64](0, inf, 0.5))
+	assert.Equal(t, -10.0, maths.Lerp[float64](0, 10, -1))
+
+	assert.Equal(t, 0.25, maths.InverseLerp[float64](0, 10, 2.5))
+	assert.Equal(t, 0.0, maths.InverseLerp[float64](3, 3, 5))
+	assert.Equal(t, 50.0, maths.Remap[float64](0.5, 0, 1, 0, 100))
+	assert.Equal(t, -1.0, maths.Remap[float64](30, 10, 20, 1, 0))
+}
+
+func TestSmoothStep(t *testing.T) {
+	assert.Equal(t, 0.0, maths.SmoothStep[float64](1, 2, 0))
+	assert.Equal(t, 0.5, maths.SmoothStep[float64](1, 2, 1.5))
+	assert.Equal(t, 1.0, maths.SmoothStep[float64](1, 2, 3))
+	assert.Equal(t, 0.0, maths.SmoothStep[float64](1, 1, 0.5))
+	assert.Equal(t, 1.0, maths.SmoothStep[float64](1, 1, 1))
+	assert.Equal(t, 1.0, maths.SmoothStep[float64](0, 1, inf))
+}
+
+func TestWrapAngle(t *testing.T) {
+	cases := map[string]struct {
+		angle, expected float64
+	}{
+		"zero":           {0, 0},
+		"pi":             {math.Pi, math.Pi},
+		"negative pi":    {-math.Pi, math.Pi},
+		"just past pi":   {math.Pi + 0.5, -math.Pi + 0.5},
+		"full turn":      {2 * math.Pi, 0},
+		"many turns":     {7*2*math.Pi + 1, 1},
+		"negative turns": {-5*2*math.Pi - 1, -1},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, c.expected, maths.WrapAngle(c.angle), 1e-9)
+		})
+	}
+
+	assert.True(t, math.IsNaN(maths.WrapAngle(inf)))
+	assert.True(t, math.IsNaN(maths.WrapAngle(nan)))
+	assert.InDelta(t, float32(1), maths.WrapAngle[float32](1+2*math.Pi), 1e-6)
+}
+
+func TestAngleDifference(t *testing.T) {
+	assert.InDelta(t, 0.2, maths.AngleDifference(math.Pi-0.1, -math.Pi+0.1), 1e-9)
+	assert.InDelta(t, -0.2, maths.AngleDifference(-math.Pi+0.1, math.Pi-0.1), 1e-9)
+	assert.InDelta(t, math.Pi, maths.AngleDifference(0, math.Pi), 1e-9)
+	assert.InDelta(t, -math.Pi/2, maths.AngleDifference(0, 3*math.Pi/2), 1e-9)
+
+	assert.InDelta(t, math.Pi, math.Abs(maths.LerpAngle(math.Pi-0.1, -math.Pi+0.1, 0.5)), 1e-9)
+}
+
+func TestApproxEqual(t *testing.T) {
+	assert.True(t, maths.ApproxEqual(1.0, 1.0+1e-10, 1e-9))
+	assert.False(t, maths.ApproxEqual(1.0, 1.1, 1e-9))
+	assert.True(t, maths.ApproxEqual(inf, inf, 1e-9))
+	assert.False(t, maths.ApproxEqual(inf, ninf, 1e-9))
+	assert.False(t, maths.ApproxEqual(inf, 1e308, 1e-9))
+	assert.False(t, maths.ApproxEqual(nan, nan, inf))
+	assert.True(t, maths.ApproxEqual(0, math.Copysign(0, -1), 0))
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, -1, maths.Sign(-5))
+	assert.Equal(t, 0, maths.Sign(0))
+	assert.Equal(t, int64(1), maths.Sign[int64](9))
+	assert.Equal(t, 1.0, maths.Sign(inf))
+	assert.Equal(t, -1.0, maths.Sign(ninf))
+	assert.True(t, math.Signbit(maths.Sign(math.Copysign(0, -1))))
+	assert.True(t, math.IsNaN(maths.Sign(nan)))
+}
+
+func TestInterval(t *testing.T) {
+	a := maths.NewInterval(5, 1)
+	b := maths.NewInterval(3, 8)
+	c := maths.NewInterval(10, 12)
+	empty := maths.Interval[int]{Min: 1, Max: 0}
+
+	assert.Equal(t, maths.Interval[int]{Min: 1, Max: 5}, a)
+	assert.Equal(t, 4, a.Length())
+	assert.Equal(t, 0, empty.Length())
+	assert.True(t, empty.Empty())
+	assert.True(t, a.Contains(5))
+	assert.False(t, a.Contains(6))
+	assert.Equal(t, 5, a.Clamp(9))
+
+	assert.True(t, a.Overlaps(b))
+	assert.True(t, a.Overlaps(maths.NewInterval(5, 6)))
+	assert.False(t, a.Overlaps(c))
+	assert.False(t, a.Overlaps(empty))
+
+	assert.Equal(t, 2, a.Overlap(b))
+	assert.Equal(t, -2, b.Overlap(c))
+
+	i, ok := a.Intersection(b)
+	assert.True(t, ok)
+	assert.Equal(t, maths.Interval[int]{Min: 3, Max: 5}, i)
+	_, ok = a.Intersection(c)
+	assert.False(t, ok)
+
+	assert.Equal(t, maths.Interval[int]{Min: 1, Max: 12}, a.Union(c))
+	assert.Equal(t, a, a.Union(empty))
+	assert.Equal(t, a, empty.Union(a))
+	assert.Equal(t, maths.Interval[int]{Min: 0, Max: 6}, a.Expand(1))
+
+	unbounded := maths.NewInterval(ninf, inf)
+	assert.True(t, unbounded.Contains(1e300))
+	assert.Equal(t, inf, unbounded.Length())
+	assert.True(t, maths.Interval[float64]{Min: nan, Max: 1}.Empty())
+}
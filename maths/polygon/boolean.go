@@ -0,0 +1,279 @@
+package polygon
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"math"
+	"sort"
+)
+
+type operation int
+
+const (
+	opUnion operation = iota
+	opIntersection
+	opDifference
+)
+
+// fragment is a piece of a ring edge that does not cross the other polygon's boundary.
+type fragment struct {
+	from, to maths.Vector2
+	used     bool
+}
+
+// Union returns the area covered by either polygon.
+func Union(a, b Polygon) []Polygon {
+	return clip(a, b, opUnion)
+}
+
+// Intersection returns the area covered by both polygons.
+func Intersection(a, b Polygon) []Polygon {
+	return clip(a, b, opIntersection)
+}
+
+// Difference returns the area covered by a but not b.
+func Difference(a, b Polygon) []Polygon {
+	return clip(a, b, opDifference)
+}
+
+// clip splits every edge of both polygons where it meets the other polygon, keeps the pieces that
+// border the result and links them back up into rings. Because outer rings wind counter-clockwise
+// and holes clockwise, the winding of each linked ring says whether it is an outer ring or a hole.
+func clip(a, b Polygon, op operation) []Polygon {
+	a, b = a.Normalize(), b.Normalize()
+	aEdges, bEdges := edges(a), edges(b)
+	aFrags, bFrags := split(aEdges, bEdges)
+
+	shared := make(map[[2]maths.Vector2]bool, len(bFrags))
+	for _, f := range bFrags {
+		shared[[2]maths.Vector2{f.from, f.to}] = true
+	}
+	var kept []fragment
+	for _, f := range aFrags {
+		switch {
+		case shared[[2]maths.Vector2{f.from, f.to}]:
+			// both boundaries run the same way, the area is on the same side
+			if op != opDifference {
+				kept = append(kept, f)
+			}
+		case shared[[2]maths.Vector2{f.to, f.from}]:
+			// the boundaries run opposite ways, so the polygons touch along this edge
+			if op == opDifference {
+				kept = append(kept, f)
+			}
+		case b.ContainsVec(f.from.Lerp(f.to, 0.5)) == (op == opIntersection):
+			kept = append(kept, f)
+		}
+	}
+
+	shared = make(map[[2]maths.Vector2]bool, len(aFrags))
+	for _, f := range aFrags {
+		shared[[2]maths.Vector2{f.from, f.to}] = true
+		shared[[2]maths.Vector2{f.to, f.from}] = true
+	}
+	for _, f := range bFrags {
+		if shared[[2]maths.Vector2{f.from, f.to}] {
+			// handled with a's fragments
+			continue
+		}
+		inside := a.ContainsVec(f.from.Lerp(f.to, 0.5))
+		switch op {
+		case opUnion:
+			if !inside {
+				kept = append(kept, f)
+			}
+		case opIntersection:
+			if inside {
+				kept = append(kept, f)
+			}
+		case opDifference:
+			if inside {
+				kept = append(kept, fragment{from: f.to, to: f.from})
+			}
+		}
+	}
+
+	return assemble(link(kept))
+}
+
+func edges(p Polygon) []maths.Segment {
+	var result []maths.Segment
+	for _, ring := range append([][]maths.Vector2{p.Outer}, p.Holes...) {
+		for i := range ring {
+			a, b := ring[i], ring[(i+1)%len(ring)]
+			if a != b {
+				result = append(result, maths.Segment{A: a, B: b})
+			}
+		}
+	}
+	return result
+}
+
+// split cuts the edges of both polygons at every point where they meet.
+// The same point value is recorded on both edges so the fragments join up exactly.
+func split(aEdges, bEdges []maths.Segment) ([]fragment, []fragment) {
+	aCuts := make([][]maths.Vector2, len(aEdges))
+	bCuts := make([][]maths.Vector2, len(bEdges))
+	for i, ea := range aEdges {
+		for j, eb := range bEdges {
+			for _, p := range crossings(ea, eb) {
+				aCuts[i] = append(aCuts[i], p)
+				bCuts[j] = append(bCuts[j], p)
+			}
+		}
+	}
+	return fragments(aEdges, aCuts), fragments(bEdges, bCuts)
+}
+
+func fragments(segments []maths.Segment, cuts [][]maths.Vector2) []fragment {
+	var result []fragment
+	for i, s := range segments {
+		points := append([]maths.Vector2{s.A, s.B}, cuts[i]...)
+		dir := s.B.Sub(s.A)
+		sort.SliceStable(points, func(i, j int) bool {
+			return points[i].Sub(s.A).Dot(dir) < points[j].Sub(s.A).Dot(dir)
+		})
+		for k := 1; k < len(points); k++ {
+			if points[k-1] != points[k] {
+				result = append(result, fragment{from: points[k-1], to: points[k]})
+			}
+		}
+	}
+	return result
+}
+
+// crossings returns the points where two segments meet. Points at or near an endpoint are
+// snapped to that endpoint, and overlapping collinear segments meet at the ends of the overlap.
+func crossings(a, b maths.Segment) []maths.Vector2 {
+	da, db := a.B.Sub(a.A), b.B.Sub(b.A)
+	denom := da.Cross(db)
+	lengths := da.Magnitude() * db.Magnitude()
+
+	if math.Abs(denom) <= maths.Epsilon*lengths {
+		// parallel, only collinear overlaps meet
+		if math.Abs(b.A.Sub(a.A).Cross(da)) > maths.Epsilon*da.Magnitude2() {
+			return nil
+		}
+		var points []maths.Vector2
+		for _, p := range [4]maths.Vector2{a.A, a.B, b.A, b.B} {
+			if onSegment(a, p) && onSegment(b, p) {
+				points = append(points, p)
+			}
+		}
+		return points
+	}
+
+	ab := b.A.Sub(a.A)
+	t := ab.Cross(db) / denom
+	u := ab.Cross(da) / denom
+	const snap = 1e-9
+	if t < -snap || t > 1+snap || u < -snap || u > 1+snap {
+		return nil
+	}
+	switch {
+	case math.Abs(u) <= snap:
+		return []maths.Vector2{b.A}
+	case math.Abs(u-1) <= snap:
+		return []maths.Vector2{b.B}
+	case math.Abs(t) <= snap:
+		return []maths.Vector2{a.A}
+	case math.Abs(t-1) <= snap:
+		return []maths.Vector2{a.B}
+	}
+	return []maths.Vector2{a.A.Add(da.Multiply(t))}
+}
+
+// onSegment reports whether p, known to be on the segment's line, is between its ends.
+func onSegment(s maths.Segment, p maths.Vector2) bool {
+	d := s.B.Sub(s.A)
+	t := p.Sub(s.A).Dot(d)
+	return t >= 0 && t <= d.Magnitude2()
+}
+
+// link joins fragments end to end into closed rings. Where several fragments leave the same point
+// the one turning furthest left is taken. The result area is always on the left of a ring, so this
+// keeps rings that only touch at a point apart.
+func link(frags []fragment) [][]maths.Vector2 {
+	outgoing := make(map[maths.Vector2][]int, len(frags))
+	for i, f := range frags {
+		outgoing[f.from] = append(outgoing[f.from], i)
+	}
+
+	var rings [][]maths.Vector2
+	for start := range frags {
+		if frags[start].used {
+			continue
+		}
+		var ring []maths.Vector2
+		current := start
+		for current != -1 && !frags[current].used {
+			f := &frags[current]
+			f.used = true
+			ring = append(ring, f.from)
+
+			dir := f.to.Sub(f.from)
+			current = -1
+			bestAngle := math.Inf(-1)
+			for _, i := range outgoing[f.to] {
+				if frags[i].used {
+					continue
+				}
+				out := frags[i].to.Sub(frags[i].from)
+				angle := math.Atan2(dir.Cross(out), dir.Dot(out))
+				if angle > bestAngle {
+					current, bestAngle = i, angle
+				}
+			}
+		}
+		ring = removeCollinear(ring)
+		if len(ring) >= 3 {
+			rings = append(rings, ring)
+		}
+	}
+	return rings
+}
+
+func removeCollinear(ring []maths.Vector2) []maths.Vector2 {
+	for changed := true; changed && len(ring) >= 3; {
+		changed = false
+		n := len(ring)
+		for i := 0; i < n; i++ {
+			a, b, c := ring[(i+n-1)%n], ring[i], ring[(i+1)%n]
+			if math.Abs(orientation(a, b, c)) <= maths.Epsilon*c.Sub(a).Magnitude2() && b.Sub(a).Dot(c.Sub(b)) >= 0 {
+				ring = append(ring[:i], ring[i+1:]...)
+				changed = true
+				break
+			}
+		}
+	}
+	return ring
+}
+
+// assemble sorts rings into outer rings and holes, putting each hole in the smallest outer ring
+// that contains it.
+func assemble(rings [][]maths.Vector2) []Polygon {
+	var result []Polygon
+	var holes [][]maths.Vector2
+	for _, ring := range rings {
+		if SignedArea(ring) > 0 {
+			result = append(result, Polygon{Outer: ring})
+		} else {
+			holes = append(holes, ring)
+		}
+	}
+
+	for _, hole := range holes {
+		best := -1
+		bestArea := math.Inf(1)
+		probe := hole[0].Lerp(hole[1], 0.5)
+		for i, p := range result {
+			area := SignedArea(p.Outer)
+			if area < bestArea && RingContains(p.Outer, probe) {
+				best, bestArea = i, area
+			}
+		}
+		if best != -1 {
+			result[best].Holes = append(result[best].Holes, hole)
+		}
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+package polygon
+
+import "github.com/soupstoregames/gamelib/maths"
+
+// ConvexDecompose splits the polygon into convex pieces.
+// It triangulates and then removes diagonals wherever the two pieces either side would still be
+// convex (Hertel-Mehlhorn), which gives at most four times the optimal number of pieces.
+func ConvexDecompose(p Polygon) []maths.Polygon {
+	var triangles []maths.Triangle
+	Triangulate(&triangles, p)
+
+	pieces := make([][]maths.Vector2, len(triangles))
+	for i, t := range triangles {
+		pieces[i] = []maths.Vector2{t.A, t.B, t.C}
+	}
+
+	for merged := true; merged; {
+		merged = false
+		for i := 0; i < len(pieces); i++ {
+			for j := i + 1; j < len(pieces); j++ {
+				joined, ok := joinPieces(pieces[i], pieces[j])
+				if !ok || !convex(joined) {
+					continue
+				}
+				pieces[i] = joined
+				pieces = append(pieces[:j], pieces[j+1:]...)
+				merged = true
+				j--
+			}
+		}
+	}
+
+	result := make([]maths.Polygon, len(pieces))
+	for i, piece := range pieces {
+		result[i] = maths.Polygon{Vertices: piece}
+	}
+	return result
+}
+
+// joinPieces merges two counter-clockwise rings that share an edge.
+func joinPieces(a, b []maths.Vector2) ([]maths.Vector2, bool) {
+	for i := range a {
+		from, to := a[i], a[(i+1)%len(a)]
+		for j := range b {
+			if b[j] != to || b[(j+1)%len(b)] != from {
+				continue
+			}
+			// walk a from the end of the shared edge round to its start, then b the same way
+			joined := make([]maths.Vector2, 0, len(a)+len(b)-2)
+			for k := 1; k <= len(a); k++ {
+				joined = append(joined, a[(i+k)%len(a)])
+			}
+			for k := 2; k < len(b); k++ {
+				joined = append(joined, b[(j+k)%len(b)])
+			}
+			return joined, true
+		}
+	}
+	return nil, false
+}
+
+func convex(ring []maths.Vector2) bool {
+	n := len(ring)
+	for i := range ring {
+		if orientation(ring[(i+n-1)%n], ring[i], ring[(i+1)%n]) < -maths.Epsilon {
+			return false
+		}
+	}
+	return true
+}
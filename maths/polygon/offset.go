@@ -0,0 +1,43 @@
+package polygon
+
+import "github.com/soupstoregames/gamelib/maths"
+
+// MiterLimit is how many times the offset distance a corner may extend before it is bevelled.
+const MiterLimit = 2
+
+// Offset grows the polygon by distance, or shrinks it when distance is negative.
+// Holes shrink as the outer ring grows. Sharp corners are mitred up to MiterLimit and bevelled past it.
+// Offsetting further than a feature is wide can make rings self-intersect.
+func Offset(p Polygon, distance float64) Polygon {
+	p = p.Normalize()
+	result := Polygon{Outer: offsetRing(p.Outer, distance)}
+	for _, hole := range p.Holes {
+		result.Holes = append(result.Holes, offsetRing(hole, distance))
+	}
+	return result
+}
+
+// offsetRing moves each edge along its right hand normal, which points away from the solid area
+// for both counter-clockwise outer rings and clockwise holes.
+func offsetRing(ring []maths.Vector2, distance float64) []maths.Vector2 {
+	n := len(ring)
+	normals := make([]maths.Vector2, n)
+	for i := range ring {
+		e := ring[(i+1)%n].Sub(ring[i])
+		normals[i] = maths.Vector2{X: e.Y, Y: -e.X}.Normalize()
+	}
+
+	result := make([]maths.Vector2, 0, n)
+	for i, v := range ring {
+		n1, n2 := normals[(i+n-1)%n], normals[i]
+		cos := n1.Dot(n2)
+		// the miter length is distance / cos(half angle) = distance * sqrt(2 / (1 + cos)).
+		// corners turning away from the offset pull in rather than stick out, so they always mitre
+		if 1+cos > 2.0/(MiterLimit*MiterLimit) || (n1.Cross(n2)*distance <= 0 && 1+cos > maths.Epsilon) {
+			result = append(result, v.Add(n1.Add(n2).Multiply(distance/(1+cos))))
+			continue
+		}
+		result = append(result, v.Add(n1.Multiply(distance)), v.Add(n2.Multiply(distance)))
+	}
+	return result
+}
@@ -0,0 +1,88 @@
+// Package polygon works with simple polygons that may be concave and may contain holes.
+package polygon
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"math"
+)
+
+// Polygon is an outer ring with zero or more holes.
+// Rings are closed implicitly, the last vertex connects back to the first.
+type Polygon struct {
+	Outer []maths.Vector2
+	Holes [][]maths.Vector2
+}
+
+// Normalize returns a copy with the outer ring counter-clockwise and the holes clockwise.
+// The operations in this package expect normalized polygons and normalize their inputs.
+func (p Polygon) Normalize() Polygon {
+	n := Polygon{Outer: orient(p.Outer, true)}
+	for _, hole := range p.Holes {
+		n.Holes = append(n.Holes, orient(hole, false))
+	}
+	return n
+}
+
+// Area returns the area of the outer ring minus the area of the holes.
+func (p Polygon) Area() float64 {
+	area := math.Abs(SignedArea(p.Outer))
+	for _, hole := range p.Holes {
+		area -= math.Abs(SignedArea(hole))
+	}
+	return area
+}
+
+func (p Polygon) Bounds() maths.Rectangle {
+	return maths.Polygon{Vertices: p.Outer}.Bounds()
+}
+
+// ContainsVec reports whether v is inside the outer ring and outside every hole.
+func (p Polygon) ContainsVec(v maths.Vector2) bool {
+	if !RingContains(p.Outer, v) {
+		return false
+	}
+	for _, hole := range p.Holes {
+		if RingContains(hole, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// SignedArea returns the area of the ring, positive when it winds counter-clockwise.
+func SignedArea(ring []maths.Vector2) float64 {
+	return maths.Polygon{Vertices: ring}.SignedArea()
+}
+
+// RingContains reports whether v is inside the ring using the even-odd rule.
+func RingContains(ring []maths.Vector2, v maths.Vector2) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.Y > v.Y) != (b.Y > v.Y) && v.X < (b.X-a.X)*(v.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// orient returns a copy of the ring wound in the requested direction.
+func orient(ring []maths.Vector2, counterClockwise bool) []maths.Vector2 {
+	out := make([]maths.Vector2, len(ring))
+	copy(out, ring)
+	if (SignedArea(out) > 0) != counterClockwise {
+		reverse(out)
+	}
+	return out
+}
+
+func reverse(ring []maths.Vector2) {
+	for i, j := 0, len(ring)-1; i < j; i, j = i+1, j-1 {
+		ring[i], ring[j] = ring[j], ring[i]
+	}
+}
+
+// orientation is positive when a, b, c turn counter-clockwise.
+func orientation(a, b, c maths.Vector2) float64 {
+	return b.Sub(a).Cross(c.Sub(a))
+}
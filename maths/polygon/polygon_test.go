@@ -0,0 +1,201 @@
+package polygon_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/maths/polygon"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func square(x, y, size float64) []maths.Vector2 {
+	return []maths.Vector2{{X: x, Y: y}, {X: x + size, Y: y}, {X: x + size, Y: y + size}, {X: x, Y: y + size}}
+}
+
+// an L shaped room with a pillar in the corner
+var room = polygon.Polygon{
+	Outer: []maths.Vector2{{X: 0, Y: 0}, {X: 6, Y: 0}, {X: 6, Y: 2}, {X: 2, Y: 2}, {X: 2, Y: 6}, {X: 0, Y: 6}},
+	Holes: [][]maths.Vector2{square(0.5, 0.5, 1)},
+}
+
+func totalArea(polygons []polygon.Polygon) float64 {
+	var area float64
+	for _, p := range polygons {
+		area += p.Area()
+	}
+	return area
+}
+
+func TestPolygon_ContainsVec(t *testing.T) {
+	assert.True(t, room.ContainsVec(maths.Vector2{X: 4, Y: 1}))
+	assert.False(t, room.ContainsVec(maths.Vector2{X: 4, Y: 4}), "outside the L")
+	assert.False(t, room.ContainsVec(maths.Vector2{X: 1, Y: 1}), "in the hole")
+	assert.Equal(t, 19.0, room.Area())
+}
+
+func TestTriangulate(t *testing.T) {
+	cases := map[string]polygon.Polygon{
+		"convex":    {Outer: square(0, 0, 2)},
+		"concave":   {Outer: room.Outer},
+		"hole":      room,
+		"clockwise": {Outer: []maths.Vector2{{X: 0, Y: 6}, {X: 2, Y: 6}, {X: 2, Y: 2}, {X: 6, Y: 2}, {X: 6, Y: 0}, {X: 0, Y: 0}}},
+		"two holes": {
+			Outer: square(0, 0, 10),
+			Holes: [][]maths.Vector2{square(2, 2, 2), square(6, 5, 3)},
+		},
+	}
+
+	for name, p := range cases {
+		t.Run(name, func(t *testing.T) {
+			var triangles []maths.Triangle
+			polygon.Triangulate(&triangles, p)
+
+			var area float64
+			for _, tri := range triangles {
+				signed := maths.Polygon{Vertices: []maths.Vector2{tri.A, tri.B, tri.C}}.SignedArea()
+				assert.Greater(t, signed, 0.0, "counter-clockwise")
+				area += signed
+				assert.True(t, p.ContainsVec(tri.Centroid()), "triangle inside the polygon")
+			}
+			assert.InDelta(t, p.Area(), area, 1e-9)
+		})
+	}
+}
+
+func TestConvexDecompose(t *testing.T) {
+	pieces := polygon.ConvexDecompose(room)
+	var area float64
+	for _, piece := range pieces {
+		area += piece.Area()
+		n := len(piece.Vertices)
+		for i := range piece.Vertices {
+			a, b, c := piece.Vertices[(i+n-1)%n], piece.Vertices[i], piece.Vertices[(i+1)%n]
+			assert.GreaterOrEqual(t, b.Sub(a).Cross(c.Sub(b)), -1e-9, "convex")
+		}
+	}
+	assert.InDelta(t, room.Area(), area, 1e-9)
+
+	var triangles []maths.Triangle
+	polygon.Triangulate(&triangles, room)
+	assert.Less(t, len(pieces), len(triangles))
+
+	assert.Len(t, polygon.ConvexDecompose(polygon.Polygon{Outer: square(0, 0, 1)}), 1)
+}
+
+func TestBoolean(t *testing.T) {
+	a := polygon.Polygon{Outer: square(0, 0, 2)}
+
+	cases := map[string]struct {
+		b            polygon.Polygon
+		union        float64
+		unionCount   int
+		intersection float64
+		difference   float64
+	}{
+		"overlapping": {
+			b:            polygon.Polygon{Outer: square(1, 1, 2)},
+			union:        7,
+			unionCount:   1,
+			intersection: 1,
+			difference:   3,
+		},
+		"disjoint": {
+			b:            polygon.Polygon{Outer: square(5, 5, 1)},
+			union:        5,
+			unionCount:   2,
+			intersection: 0,
+			difference:   4,
+		},
+		"sharing an edge": {
+			b:            polygon.Polygon{Outer: square(2, 0, 2)},
+			union:        8,
+			unionCount:   1,
+			intersection: 0,
+			difference:   4,
+		},
+		"touching at a corner": {
+			b:            polygon.Polygon{Outer: square(2, 2, 1)},
+			union:        5,
+			unionCount:   2,
+			intersection: 0,
+			difference:   4,
+		},
+		"contained": {
+			b:            polygon.Polygon{Outer: square(0.5, 0.5, 1)},
+			union:        4,
+			unionCount:   1,
+			intersection: 1,
+			difference:   3,
+		},
+		"identical": {
+			b:            a,
+			union:        4,
+			unionCount:   1,
+			intersection: 4,
+			difference:   0,
+		},
+		"overlapping edge": {
+			b:            polygon.Polygon{Outer: square(1, -1, 2)},
+			union:        7,
+			unionCount:   1,
+			intersection: 1,
+			difference:   3,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			union := polygon.Union(a, c.b)
+			assert.InDelta(t, c.union, totalArea(union), 1e-9, "union")
+			assert.Len(t, union, c.unionCount)
+			assert.InDelta(t, c.intersection, totalArea(polygon.Intersection(a, c.b)), 1e-9, "intersection")
+			assert.InDelta(t, c.difference, totalArea(polygon.Difference(a, c.b)), 1e-9, "difference")
+		})
+	}
+}
+
+func TestBoolean_Holes(t *testing.T) {
+	// cutting a window out of a wall leaves a hole
+	diff := polygon.Difference(polygon.Polygon{Outer: square(0, 0, 4)}, polygon.Polygon{Outer: square(1, 1, 2)})
+	assert.Len(t, diff, 1)
+	assert.Len(t, diff[0].Holes, 1)
+	assert.InDelta(t, 12, diff[0].Area(), 1e-9)
+
+	// filling part of the hole back in
+	union := polygon.Union(diff[0], polygon.Polygon{Outer: square(1, 1, 1)})
+	assert.Len(t, union, 1)
+	assert.Len(t, union[0].Holes, 1)
+	assert.InDelta(t, 13, union[0].Area(), 1e-9)
+
+	// the room with the pillar clipped to its bottom arm
+	clipped := polygon.Intersection(room, polygon.Polygon{Outer: square(0, 0, 3)})
+	assert.InDelta(t, 7, totalArea(clipped), 1e-9)
+}
+
+func TestSimplify(t *testing.T) {
+	path := []maths.Vector2{{X: 0, Y: 0}, {X: 1, Y: 0.05}, {X: 2, Y: -0.05}, {X: 3, Y: 0}, {X: 3, Y: 3}}
+	assert.Equal(t, []maths.Vector2{{X: 0, Y: 0}, {X: 3, Y: 0}, {X: 3, Y: 3}}, polygon.Simplify(path, 0.1))
+	assert.Equal(t, path, polygon.Simplify(path, 0.01))
+
+	ring := []maths.Vector2{{X: 0, Y: 0}, {X: 1, Y: 0.01}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 1, Y: 2.01}, {X: 0, Y: 2}}
+	assert.Equal(t, []maths.Vector2{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}}, polygon.SimplifyRing(ring, 0.1))
+}
+
+func TestOffset(t *testing.T) {
+	p := polygon.Polygon{Outer: square(0, 0, 4), Holes: [][]maths.Vector2{square(1, 1, 2)}}
+
+	grown := polygon.Offset(p, 0.5)
+	assert.InDelta(t, 25-1, grown.Area(), 1e-9, "outer grows and the hole shrinks")
+
+	shrunk := polygon.Offset(p, -0.5)
+	assert.InDelta(t, 9-9, shrunk.Area(), 1e-9)
+
+	// a sharp spike is bevelled rather than extended
+	spike := polygon.Polygon{Outer: []maths.Vector2{{X: 0, Y: 0}, {X: 10, Y: 0.5}, {X: 0, Y: 1}}}
+	offset := polygon.Offset(spike, 0.1)
+	assert.Len(t, offset.Outer, 4)
+	for _, v := range offset.Outer {
+		assert.Less(t, v.X, 10+0.1*polygon.MiterLimit+1e-9)
+	}
+	assert.True(t, math.Abs(offset.Area()) > spike.Area())
+}
@@ -0,0 +1,69 @@
+package polygon
+
+import "github.com/soupstoregames/gamelib/maths"
+
+// Simplify removes points from an open path that are within tolerance of the simplified line,
+// using the Ramer-Douglas-Peucker algorithm. The first and last points are always kept.
+func Simplify(path []maths.Vector2, tolerance float64) []maths.Vector2 {
+	if len(path) < 3 {
+		return append([]maths.Vector2(nil), path...)
+	}
+	keep := make([]bool, len(path))
+	keep[0], keep[len(path)-1] = true, true
+	simplify(path, keep, 0, len(path)-1, tolerance)
+
+	var result []maths.Vector2
+	for i, v := range path {
+		if keep[i] {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// SimplifyRing simplifies a closed ring. The ring is split at the vertex furthest from the first so
+// that both halves are simplified as open paths.
+func SimplifyRing(ring []maths.Vector2, tolerance float64) []maths.Vector2 {
+	if len(ring) < 4 {
+		return append([]maths.Vector2(nil), ring...)
+	}
+	far := 0
+	for i, v := range ring {
+		if v.Distance2(ring[0]) > ring[far].Distance2(ring[0]) {
+			far = i
+		}
+	}
+
+	closed := append(append([]maths.Vector2(nil), ring...), ring[0])
+	keep := make([]bool, len(closed))
+	keep[0], keep[far], keep[len(closed)-1] = true, true, true
+	simplify(closed, keep, 0, far, tolerance)
+	simplify(closed, keep, far, len(closed)-1, tolerance)
+
+	var result []maths.Vector2
+	for i, v := range closed[:len(closed)-1] {
+		if keep[i] {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func simplify(path []maths.Vector2, keep []bool, first, last int, tolerance float64) {
+	if last-first < 2 {
+		return
+	}
+	segment := maths.Segment{A: path[first], B: path[last]}
+	furthest, furthestDist := -1, tolerance*tolerance
+	for i := first + 1; i < last; i++ {
+		if d := segment.ClosestPoint(path[i]).Distance2(path[i]); d > furthestDist {
+			furthest, furthestDist = i, d
+		}
+	}
+	if furthest == -1 {
+		return
+	}
+	keep[furthest] = true
+	simplify(path, keep, first, furthest, tolerance)
+	simplify(path, keep, furthest, last, tolerance)
+}
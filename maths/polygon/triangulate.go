@@ -0,0 +1,190 @@
+package polygon
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"math"
+	"sort"
+)
+
+// Triangulate appends a counter-clockwise triangulation of the polygon to triangles.
+// Holes are first bridged into the outer ring to make a single ring, which is then ear clipped.
+func Triangulate(triangles *[]maths.Triangle, p Polygon) {
+	p = p.Normalize()
+	ring := bridgeHoles(p.Outer, p.Holes)
+	earClip(triangles, ring)
+}
+
+// bridgeHoles joins each hole to the outer ring with a pair of coincident edges.
+// Holes are processed from the rightmost first so each bridge only has to cross the ring built so far.
+func bridgeHoles(outer []maths.Vector2, holes [][]maths.Vector2) []maths.Vector2 {
+	ring := make([]maths.Vector2, len(outer))
+	copy(ring, outer)
+
+	type hole struct {
+		vertices  []maths.Vector2
+		rightmost int
+	}
+	sorted := make([]hole, 0, len(holes))
+	for _, h := range holes {
+		if len(h) < 3 {
+			continue
+		}
+		rightmost := 0
+		for i, v := range h {
+			if v.X > h[rightmost].X {
+				rightmost = i
+			}
+		}
+		sorted = append(sorted, hole{vertices: h, rightmost: rightmost})
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].vertices[sorted[i].rightmost].X > sorted[j].vertices[sorted[j].rightmost].X
+	})
+
+	for _, h := range sorted {
+		m := h.vertices[h.rightmost]
+		bridge := visibleVertex(ring, m)
+		if bridge == -1 {
+			continue
+		}
+
+		joined := make([]maths.Vector2, 0, len(ring)+len(h.vertices)+2)
+		joined = append(joined, ring[:bridge+1]...)
+		for i := 0; i <= len(h.vertices); i++ {
+			joined = append(joined, h.vertices[(h.rightmost+i)%len(h.vertices)])
+		}
+		joined = append(joined, ring[bridge:]...)
+		ring = joined
+	}
+	return ring
+}
+
+// visibleVertex finds a vertex of the ring that can be connected to m, a point inside it, without
+// crossing any edge. It casts a ray from m along +X to find the nearest edge, then corrects for
+// reflex vertices that block the view of that edge's endpoint.
+func visibleVertex(ring []maths.Vector2, m maths.Vector2) int {
+	bestX := math.Inf(1)
+	candidate := -1
+	var hit maths.Vector2
+	for i := range ring {
+		a, b := ring[i], ring[(i+1)%len(ring)]
+		// only edges that straddle the ray
+		if a.Y == b.Y || math.Min(a.Y, b.Y) > m.Y || math.Max(a.Y, b.Y) < m.Y {
+			continue
+		}
+		x := a.X + (m.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y)
+		if x < m.X || x >= bestX {
+			continue
+		}
+		bestX = x
+		hit = maths.Vector2{X: x, Y: m.Y}
+		switch {
+		case x == a.X && m.Y == a.Y:
+			candidate = i
+		case x == b.X && m.Y == b.Y:
+			candidate = (i + 1) % len(ring)
+		case a.X > b.X:
+			candidate = i
+		default:
+			candidate = (i + 1) % len(ring)
+		}
+	}
+	if candidate == -1 || ring[candidate] == hit {
+		return candidate
+	}
+
+	// reflex vertices inside the triangle m, hit, candidate can hide the candidate,
+	// so take the one closest in angle to the ray instead
+	p := ring[candidate]
+	bestAngle := math.Inf(1)
+	bestDist := math.Inf(1)
+	for i, v := range ring {
+		if i == candidate || v.X < m.X || !reflex(ring, i) {
+			continue
+		}
+		if !inTriangle(v, m, hit, p) {
+			continue
+		}
+		d := v.Sub(m)
+		angle := math.Abs(math.Atan2(d.Y, d.X))
+		dist := d.Magnitude2()
+		if angle < bestAngle || (angle == bestAngle && dist < bestDist) {
+			candidate, bestAngle, bestDist = i, angle, dist
+		}
+	}
+	return candidate
+}
+
+func reflex(ring []maths.Vector2, i int) bool {
+	n := len(ring)
+	return orientation(ring[(i+n-1)%n], ring[i], ring[(i+1)%n]) < 0
+}
+
+// inTriangle reports whether v is inside or on the edge of the triangle, in either winding.
+func inTriangle(v, a, b, c maths.Vector2) bool {
+	d1 := orientation(a, b, v)
+	d2 := orientation(b, c, v)
+	d3 := orientation(c, a, v)
+	hasNeg := d1 < 0 || d2 < 0 || d3 < 0
+	hasPos := d1 > 0 || d2 > 0 || d3 > 0
+	return !(hasNeg && hasPos)
+}
+
+// earClip triangulates a counter-clockwise ring by repeatedly cutting off convex corners that
+// contain no other vertex.
+func earClip(triangles *[]maths.Triangle, ring []maths.Vector2) {
+	n := len(ring)
+	if n < 3 {
+		return
+	}
+	prev := make([]int, n)
+	next := make([]int, n)
+	for i := range ring {
+		prev[i] = (i + n - 1) % n
+		next[i] = (i + 1) % n
+	}
+
+	remaining := n
+	current := 0
+	stalled := 0
+	for remaining > 3 {
+		p, c, nx := prev[current], current, next[current]
+		o := orientation(ring[p], ring[c], ring[nx])
+
+		ear := o > 0
+		if ear {
+			for i := next[nx]; i != p; i = next[i] {
+				v := ring[i]
+				// bridged holes duplicate vertices, which are never inside the ear
+				if v == ring[p] || v == ring[c] || v == ring[nx] {
+					continue
+				}
+				if inTriangle(v, ring[p], ring[c], ring[nx]) {
+					ear = false
+					break
+				}
+			}
+		}
+
+		// after a full lap without an ear the ring is degenerate, so drop a flat or the current corner
+		if ear || (stalled >= remaining && o <= 0) || stalled >= 2*remaining {
+			if o > 0 {
+				*triangles = append(*triangles, maths.Triangle{A: ring[p], B: ring[c], C: ring[nx]})
+			}
+			next[p] = nx
+			prev[nx] = p
+			remaining--
+			current = nx
+			stalled = 0
+			continue
+		}
+
+		current = nx
+		stalled++
+	}
+
+	p, c, nx := prev[current], current, next[current]
+	if orientation(ring[p], ring[c], ring[nx]) > 0 {
+		*triangles = append(*triangles, maths.Triangle{A: ring[p], B: ring[c], C: ring[nx]})
+	}
+}
@@ -0,0 +1,227 @@
+package maths
+
+import "math"
+
+// Quaternion represents a rotation in 3D space.
+// Rotation quaternions are expected to be unit length.
+type Quaternion struct {
+	X float64
+	Y float64
+	Z float64
+	W float64
+}
+
+func IdentityQuaternion() Quaternion {
+	return Quaternion{W: 1}
+}
+
+// NewQuaternionAxisAngle creates a rotation of angle radians around axis.
+func NewQuaternionAxisAngle(axis Vector3, angle float64) Quaternion {
+	mag := axis.Magnitude()
+	if mag == 0 {
+		return IdentityQuaternion()
+	}
+	s := math.Sin(angle/2) / mag
+	return Quaternion{
+		X: axis.X * s,
+		Y: axis.Y * s,
+		Z: axis.Z * s,
+		W: math.Cos(angle / 2),
+	}
+}
+
+// NewQuaternionEuler creates a rotation from euler angles in radians.
+// The rotation is applied around X first, then Y, then Z.
+func NewQuaternionEuler(x, y, z float64) Quaternion {
+	cx, sx := math.Cos(x/2), math.Sin(x/2)
+	cy, sy := math.Cos(y/2), math.Sin(y/2)
+	cz, sz := math.Cos(z/2), math.Sin(z/2)
+
+	return Quaternion{
+		X: sx*cy*cz - cx*sy*sz,
+		Y: cx*sy*cz + sx*cy*sz,
+		Z: cx*cy*sz - sx*sy*cz,
+		W: cx*cy*cz + sx*sy*sz,
+	}
+}
+
+// NewQuaternionFromMatrix4 extracts the rotation from the upper 3x3 of m.
+// m is expected to contain no scale or shear.
+func NewQuaternionFromMatrix4(m Matrix4) Quaternion {
+	var q Quaternion
+	trace := m[0] + m[5] + m[10]
+	switch {
+	case trace > 0:
+		s := math.Sqrt(trace+1) * 2
+		q.W = 0.25 * s
+		q.X = (m[9] - m[6]) / s
+		q.Y = (m[2] - m[8]) / s
+		q.Z = (m[4] - m[1]) / s
+	case m[0] > m[5] && m[0] > m[10]:
+		s := math.Sqrt(1+m[0]-m[5]-m[10]) * 2
+		q.W = (m[9] - m[6]) / s
+		q.X = 0.25 * s
+		q.Y = (m[1] + m[4]) / s
+		q.Z = (m[2] + m[8]) / s
+	case m[5] > m[10]:
+		s := math.Sqrt(1+m[5]-m[0]-m[10]) * 2
+		q.W = (m[2] - m[8]) / s
+		q.X = (m[1] + m[4]) / s
+		q.Y = 0.25 * s
+		q.Z = (m[6] + m[9]) / s
+	default:
+		s := math.Sqrt(1+m[10]-m[0]-m[5]) * 2
+		q.W = (m[4] - m[1]) / s
+		q.X = (m[2] + m[8]) / s
+		q.Y = (m[6] + m[9]) / s
+		q.Z = 0.25 * s
+	}
+	return q.Normalize()
+}
+
+// Multiply returns the rotation q2 followed by q.
+func (q Quaternion) Multiply(q2 Quaternion) Quaternion {
+	return Quaternion{
+		X: q.W*q2.X + q.X*q2.W + q.Y*q2.Z - q.Z*q2.Y,
+		Y: q.W*q2.Y - q.X*q2.Z + q.Y*q2.W + q.Z*q2.X,
+		Z: q.W*q2.Z + q.X*q2.Y - q.Y*q2.X + q.Z*q2.W,
+		W: q.W*q2.W - q.X*q2.X - q.Y*q2.Y - q.Z*q2.Z,
+	}
+}
+
+func (q Quaternion) Dot(q2 Quaternion) float64 {
+	return q.X*q2.X + q.Y*q2.Y + q.Z*q2.Z + q.W*q2.W
+}
+
+func (q Quaternion) Magnitude() float64 {
+	return math.Sqrt(q.Dot(q))
+}
+
+// Normalize returns q scaled to unit length, or the identity if q has no length.
+func (q Quaternion) Normalize() Quaternion {
+	mag := q.Magnitude()
+	if mag == 0 {
+		return IdentityQuaternion()
+	}
+	q.X /= mag
+	q.Y /= mag
+	q.Z /= mag
+	q.W /= mag
+	return q
+}
+
+func (q Quaternion) Conjugate() Quaternion {
+	q.X = -q.X
+	q.Y = -q.Y
+	q.Z = -q.Z
+	return q
+}
+
+// Inverse returns the inverse of q. For unit quaternions this is the same as the conjugate.
+func (q Quaternion) Inverse() Quaternion {
+	mag2 := q.Dot(q)
+	if mag2 == 0 {
+		return IdentityQuaternion()
+	}
+	q = q.Conjugate()
+	q.X /= mag2
+	q.Y /= mag2
+	q.Z /= mag2
+	q.W /= mag2
+	return q
+}
+
+// Rotate applies the rotation to v.
+func (q Quaternion) Rotate(v Vector3) Vector3 {
+	// v' = v + 2w(u x v) + 2u x (u x v)
+	u := Vector3{X: q.X, Y: q.Y, Z: q.Z}
+	t := u.Cross(v).Multiply(2)
+	return v.Add(t.Multiply(q.W)).Add(u.Cross(t))
+}
+
+// AxisAngle returns the rotation axis and the angle in radians.
+// The identity rotation returns the X axis and an angle of zero.
+func (q Quaternion) AxisAngle() (Vector3, float64) {
+	q = q.Normalize()
+	if q.W < 0 {
+		q = Quaternion{X: -q.X, Y: -q.Y, Z: -q.Z, W: -q.W}
+	}
+	angle := 2 * math.Acos(math.Min(q.W, 1))
+	s := math.Sqrt(1 - q.W*q.W)
+	if s < 1e-9 {
+		return Vector3{X: 1}, 0
+	}
+	return Vector3{X: q.X / s, Y: q.Y / s, Z: q.Z / s}, angle
+}
+
+// Euler returns the euler angles in radians using the same convention as NewQuaternionEuler.
+func (q Quaternion) Euler() (x, y, z float64) {
+	x = math.Atan2(2*(q.W*q.X+q.Y*q.Z), 1-2*(q.X*q.X+q.Y*q.Y))
+
+	sinY := 2 * (q.W*q.Y - q.Z*q.X)
+	if sinY >= 1 {
+		y = math.Pi / 2
+	} else if sinY <= -1 {
+		y = -math.Pi / 2
+	} else {
+		y = math.Asin(sinY)
+	}
+
+	z = math.Atan2(2*(q.W*q.Z+q.X*q.Y), 1-2*(q.Y*q.Y+q.Z*q.Z))
+	return x, y, z
+}
+
+func (q Quaternion) ToMatrix4() Matrix4 {
+	xx, yy, zz := q.X*q.X, q.Y*q.Y, q.Z*q.Z
+	xy, xz, yz := q.X*q.Y, q.X*q.Z, q.Y*q.Z
+	wx, wy, wz := q.W*q.X, q.W*q.Y, q.W*q.Z
+
+	return Matrix4{
+		1 - 2*(yy+zz), 2 * (xy - wz), 2 * (xz + wy), 0,
+		2 * (xy + wz), 1 - 2*(xx+zz), 2 * (yz - wx), 0,
+		2 * (xz - wy), 2 * (yz + wx), 1 - 2*(xx+yy), 0,
+		0, 0, 0, 1,
+	}
+}
+
+// Nlerp linearly interpolates between q and q2 and normalizes the result.
+// It is cheaper than Slerp but does not rotate at a constant speed.
+func (q Quaternion) Nlerp(q2 Quaternion, t float64) Quaternion {
+	// take the shortest path
+	if q.Dot(q2) < 0 {
+		q2 = Quaternion{X: -q2.X, Y: -q2.Y, Z: -q2.Z, W: -q2.W}
+	}
+	return Quaternion{
+		X: q.X + (q2.X-q.X)*t,
+		Y: q.Y + (q2.Y-q.Y)*t,
+		Z: q.Z + (q2.Z-q.Z)*t,
+		W: q.W + (q2.W-q.W)*t,
+	}.Normalize()
+}
+
+// Slerp spherically interpolates between q and q2 along the shortest path.
+func (q Quaternion) Slerp(q2 Quaternion, t float64) Quaternion {
+	dot := q.Dot(q2)
+	// take the shortest path
+	if dot < 0 {
+		q2 = Quaternion{X: -q2.X, Y: -q2.Y, Z: -q2.Z, W: -q2.W}
+		dot = -dot
+	}
+
+	// the rotations are nearly identical so sin(theta) is too small to divide by
+	if dot > 0.9995 {
+		return q.Nlerp(q2, t)
+	}
+
+	theta := math.Acos(dot)
+	sinTheta := math.Sin(theta)
+	a := math.Sin((1-t)*theta) / sinTheta
+	b := math.Sin(t*theta) / sinTheta
+
+	return Quaternion{
+		X: q.X*a + q2.X*b,
+		Y: q.Y*a + q2.Y*b,
+		Z: q.Z*a + q2.Z*b,
+		W: q.W*a + q2.W*b,
+	}
+}
@@ -0,0 +1,154 @@
+package maths_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+const quaternionDelta = 1e-9
+
+func assertVector3InDelta(t *testing.T, expected, actual maths.Vector3) {
+	t.Helper()
+	assert.InDelta(t, expected.X, actual.X, quaternionDelta)
+	assert.InDelta(t, expected.Y, actual.Y, quaternionDelta)
+	assert.InDelta(t, expected.Z, actual.Z, quaternionDelta)
+}
+
+func assertSameRotation(t *testing.T, expected, actual maths.Quaternion) {
+	t.Helper()
+	// q and -q represent the same rotation
+	assert.InDelta(t, 1, math.Abs(expected.Dot(actual)), quaternionDelta)
+}
+
+func TestQuaternion_Rotate(t *testing.T) {
+	cases := map[string]struct {
+		q        maths.Quaternion
+		v        maths.Vector3
+		expected maths.Vector3
+	}{
+		"identity": {
+			q:        maths.IdentityQuaternion(),
+			v:        maths.Vector3{X: 1, Y: 2, Z: 3},
+			expected: maths.Vector3{X: 1, Y: 2, Z: 3},
+		},
+		"90 degrees around z": {
+			q:        maths.NewQuaternionAxisAngle(maths.Vector3{Z: 1}, math.Pi/2),
+			v:        maths.Vector3{X: 1},
+			expected: maths.Vector3{Y: 1},
+		},
+		"180 degrees around y": {
+			q:        maths.NewQuaternionAxisAngle(maths.Vector3{Y: 1}, math.Pi),
+			v:        maths.Vector3{X: 1, Y: 1},
+			expected: maths.Vector3{X: -1, Y: 1},
+		},
+		"unnormalized axis": {
+			q:        maths.NewQuaternionAxisAngle(maths.Vector3{X: 5}, math.Pi/2),
+			v:        maths.Vector3{Y: 1},
+			expected: maths.Vector3{Z: 1},
+		},
+		"euler z": {
+			q:        maths.NewQuaternionEuler(0, 0, math.Pi/2),
+			v:        maths.Vector3{X: 1},
+			expected: maths.Vector3{Y: 1},
+		},
+		"euler x then y": {
+			q:        maths.NewQuaternionEuler(math.Pi/2, math.Pi/2, 0),
+			v:        maths.Vector3{Y: 1},
+			expected: maths.Vector3{X: 1},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assertVector3InDelta(t, c.expected, c.q.Rotate(c.v))
+		})
+	}
+}
+
+func TestQuaternion_Multiply(t *testing.T) {
+	x := maths.NewQuaternionAxisAngle(maths.Vector3{X: 1}, math.Pi/2)
+	y := maths.NewQuaternionAxisAngle(maths.Vector3{Y: 1}, math.Pi/2)
+
+	v := maths.Vector3{Y: 1}
+	assertVector3InDelta(t, y.Rotate(x.Rotate(v)), y.Multiply(x).Rotate(v))
+	assertSameRotation(t, maths.NewQuaternionEuler(math.Pi/2, math.Pi/2, 0), y.Multiply(x))
+}
+
+func TestQuaternion_Inverse(t *testing.T) {
+	q := maths.NewQuaternionEuler(0.3, -1.2, 2.5)
+	assertSameRotation(t, maths.IdentityQuaternion(), q.Multiply(q.Inverse()))
+	assertSameRotation(t, q.Conjugate(), q.Inverse())
+
+	scaled := maths.Quaternion{X: q.X * 2, Y: q.Y * 2, Z: q.Z * 2, W: q.W * 2}
+	product := scaled.Multiply(scaled.Inverse())
+	assert.InDelta(t, 1, product.W, quaternionDelta)
+
+	assert.Equal(t, maths.IdentityQuaternion(), maths.Quaternion{}.Inverse())
+	assert.Equal(t, maths.IdentityQuaternion(), maths.Quaternion{}.Normalize())
+}
+
+func TestQuaternion_Euler(t *testing.T) {
+	x, y, z := maths.NewQuaternionEuler(0.3, -1.2, 2.5).Euler()
+	assert.InDelta(t, 0.3, x, quaternionDelta)
+	assert.InDelta(t, -1.2, y, quaternionDelta)
+	assert.InDelta(t, 2.5, z, quaternionDelta)
+}
+
+func TestQuaternion_AxisAngle(t *testing.T) {
+	axis, angle := maths.NewQuaternionAxisAngle(maths.Vector3{X: 1, Y: 1}, 1).AxisAngle()
+	assertVector3InDelta(t, maths.Vector3{X: 1, Y: 1}.Normalize(), axis)
+	assert.InDelta(t, 1, angle, quaternionDelta)
+
+	axis, angle = maths.IdentityQuaternion().AxisAngle()
+	assertVector3InDelta(t, maths.Vector3{X: 1}, axis)
+	assert.Equal(t, 0.0, angle)
+}
+
+func TestQuaternion_Matrix4(t *testing.T) {
+	cases := map[string]maths.Quaternion{
+		"identity":     maths.IdentityQuaternion(),
+		"euler":        maths.NewQuaternionEuler(0.3, -1.2, 2.5),
+		"180 around x": maths.NewQuaternionAxisAngle(maths.Vector3{X: 1}, math.Pi),
+		"180 around y": maths.NewQuaternionAxisAngle(maths.Vector3{Y: 1}, math.Pi),
+		"180 around z": maths.NewQuaternionAxisAngle(maths.Vector3{Z: 1}, math.Pi),
+	}
+
+	v := maths.Vector3{X: 1, Y: -2, Z: 3}
+	for name, q := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := q.ToMatrix4()
+			assertVector3InDelta(t, q.Rotate(v), m.MultiplyPoint(v))
+			assertSameRotation(t, q, maths.NewQuaternionFromMatrix4(m))
+		})
+	}
+}
+
+func TestQuaternion_Slerp(t *testing.T) {
+	a := maths.IdentityQuaternion()
+	b := maths.NewQuaternionAxisAngle(maths.Vector3{Z: 1}, math.Pi/2)
+
+	assertSameRotation(t, a, a.Slerp(b, 0))
+	assertSameRotation(t, b, a.Slerp(b, 1))
+	assertSameRotation(t, maths.NewQuaternionAxisAngle(maths.Vector3{Z: 1}, math.Pi/4), a.Slerp(b, 0.5))
+
+	t.Run("near identity", func(t *testing.T) {
+		c := maths.NewQuaternionAxisAngle(maths.Vector3{Z: 1}, 1e-6)
+		r := a.Slerp(c, 0.5)
+		assert.InDelta(t, 1, r.Magnitude(), quaternionDelta)
+		assertSameRotation(t, maths.NewQuaternionAxisAngle(maths.Vector3{Z: 1}, 0.5e-6), r)
+	})
+
+	t.Run("antipodal", func(t *testing.T) {
+		// -b is the same rotation as b but slerping to it naively would take the long way around
+		negB := maths.Quaternion{X: -b.X, Y: -b.Y, Z: -b.Z, W: -b.W}
+		assertSameRotation(t, maths.NewQuaternionAxisAngle(maths.Vector3{Z: 1}, math.Pi/4), a.Slerp(negB, 0.5))
+	})
+
+	t.Run("nlerp endpoints", func(t *testing.T) {
+		assertSameRotation(t, a, a.Nlerp(b, 0))
+		assertSameRotation(t, b, a.Nlerp(b, 1))
+		assertSameRotation(t, maths.NewQuaternionAxisAngle(maths.Vector3{Z: 1}, math.Pi/4), a.Nlerp(b, 0.5))
+	})
+}
@@ -1,5 +1,10 @@
 package maths
 
+import (
+	"math"
+	"sort"
+)
+
 type Rectangle struct {
 	X      float64
 	Y      float64
@@ -16,7 +21,7 @@ func (r Rectangle) ContainsRect(r2 Rectangle) bool {
 }
 
 func (r Rectangle) Intersects(r2 Rectangle) bool {
-	return !(r2.X > r.X+r.Width || r2.X+r2.Width < r.X || r2.Y > r.Y+r.Height || r2.Y+r2.Height < r.Y)
+	return !(r2.X >= r.X+r.Width || r2.X+r2.Width <= r.X || r2.Y >= r.Y+r.Height || r2.Y+r2.Height <= r.Y)
 }
 
 func (r Rectangle) Merge(r2 Rectangle) (Rectangle, bool) {
@@ -77,6 +82,132 @@ func (r Rectangle) Expand(exp float64) Rectangle {
 	}
 }
 
+func (r Rectangle) Area() float64 {
+	return r.Width * r.Height
+}
+
+func (r Rectangle) Centroid() Vector2 {
+	return Vector2{X: r.X + r.Width/2, Y: r.Y + r.Height/2}
+}
+
+func (r Rectangle) Bounds() Rectangle {
+	return r
+}
+
+// ClosestPoint returns the closest point in the rectangle to v, which is v itself if it is inside.
+func (r Rectangle) ClosestPoint(v Vector2) Vector2 {
+	return Vector2{
+		X: math.Max(r.X, math.Min(r.X+r.Width, v.X)),
+		Y: math.Max(r.Y, math.Min(r.Y+r.Height, v.Y)),
+	}
+}
+
+func (r Rectangle) ToPolygon() Polygon {
+	return Polygon{Vertices: []Vector2{
+		{X: r.X, Y: r.Y},
+		{X: r.X + r.Width, Y: r.Y},
+		{X: r.X + r.Width, Y: r.Y + r.Height},
+		{X: r.X, Y: r.Y + r.Height},
+	}}
+}
+
+// Intersection returns the region covered by both rectangles.
+// It returns false if the rectangles do not overlap by a positive area.
+func (r Rectangle) Intersection(r2 Rectangle) (Rectangle, bool) {
+	minX, minY := math.Max(r.X, r2.X), math.Max(r.Y, r2.Y)
+	maxX, maxY := math.Min(r.X+r.Width, r2.X+r2.Width), math.Min(r.Y+r.Height, r2.Y+r2.Height)
+	if maxX <= minX || maxY <= minY {
+		return Rectangle{}, false
+	}
+	return Rectangle{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}, true
+}
+
+// Union returns the smallest rectangle that contains both rectangles.
+func (r Rectangle) Union(r2 Rectangle) Rectangle {
+	minX, minY := math.Min(r.X, r2.X), math.Min(r.Y, r2.Y)
+	maxX, maxY := math.Max(r.X+r.Width, r2.X+r2.Width), math.Max(r.Y+r.Height, r2.Y+r2.Height)
+	return Rectangle{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+// Subtract appends the parts of r not covered by r2 to results.
+// There are at most four: full width strips below and above r2, then the pieces either side of it.
+func (r Rectangle) Subtract(results *[]Rectangle, r2 Rectangle) {
+	overlap, ok := r.Intersection(r2)
+	if !ok {
+		*results = append(*results, r)
+		return
+	}
+
+	if overlap.Y > r.Y {
+		*results = append(*results, Rectangle{X: r.X, Y: r.Y, Width: r.Width, Height: overlap.Y - r.Y})
+	}
+	if top := overlap.Y + overlap.Height; top < r.Y+r.Height {
+		*results = append(*results, Rectangle{X: r.X, Y: top, Width: r.Width, Height: r.Y + r.Height - top})
+	}
+	if overlap.X > r.X {
+		*results = append(*results, Rectangle{X: r.X, Y: overlap.Y, Width: overlap.X - r.X, Height: overlap.Height})
+	}
+	if right := overlap.X + overlap.Width; right < r.X+r.Width {
+		*results = append(*results, Rectangle{X: right, Y: overlap.Y, Width: r.X + r.Width - right, Height: overlap.Height})
+	}
+}
+
+// MergeAll greedily coalesces rectangles, such as the solid tiles of a tile map, into fewer larger ones.
+// Touching rectangles in the same row are joined first, then rows with the same span are stacked.
+func MergeAll(rects []Rectangle) []Rectangle {
+	if len(rects) == 0 {
+		return nil
+	}
+
+	merged := make([]Rectangle, len(rects))
+	copy(merged, rects)
+
+	// join runs within each row
+	sort.Slice(merged, func(i, j int) bool {
+		a, b := merged[i], merged[j]
+		if a.Y != b.Y {
+			return a.Y < b.Y
+		}
+		if a.Height != b.Height {
+			return a.Height < b.Height
+		}
+		return a.X < b.X
+	})
+	merged = mergeSorted(merged, func(a, b Rectangle) bool {
+		return a.Y == b.Y && a.Height == b.Height
+	})
+
+	// stack rows that span the same columns
+	sort.Slice(merged, func(i, j int) bool {
+		a, b := merged[i], merged[j]
+		if a.X != b.X {
+			return a.X < b.X
+		}
+		if a.Width != b.Width {
+			return a.Width < b.Width
+		}
+		return a.Y < b.Y
+	})
+	return mergeSorted(merged, func(a, b Rectangle) bool {
+		return a.X == b.X && a.Width == b.Width
+	})
+}
+
+// mergeSorted merges each rectangle into the one before it where they share a line and touch.
+func mergeSorted(rects []Rectangle, sameLine func(a, b Rectangle) bool) []Rectangle {
+	n := 0
+	for i, r := range rects {
+		if i > 0 && sameLine(rects[n-1], r) {
+			if m, ok := rects[n-1].Merge(r); ok {
+				rects[n-1] = m
+				continue
+			}
+		}
+		rects[n] = r
+		n++
+	}
+	return rects[:n]
+}
 
 type Circle struct {
 	Center Vector2
@@ -98,6 +229,31 @@ func (s Circle) ContainsCircle(s2 Circle) bool {
 	return s.Radius >= s.Center.Distance(s2.Center)+s2.Radius
 }
 
+func (s Circle) ContainsVec(v Vector2) bool {
+	return s.Center.Distance2(v) <= s.Radius*s.Radius
+}
+
+func (s Circle) Area() float64 {
+	return math.Pi * s.Radius * s.Radius
+}
+
+func (s Circle) Centroid() Vector2 {
+	return s.Center
+}
+
+func (s Circle) Bounds() Rectangle {
+	return Rectangle{X: s.Center.X - s.Radius, Y: s.Center.Y - s.Radius, Width: s.Radius * 2, Height: s.Radius * 2}
+}
+
+// ClosestPoint returns the closest point in the circle to v, which is v itself if it is inside.
+func (s Circle) ClosestPoint(v Vector2) Vector2 {
+	offset := v.Sub(s.Center)
+	if offset.Magnitude2() <= s.Radius*s.Radius {
+		return v
+	}
+	return s.Center.Add(offset.Normalize().Multiply(s.Radius))
+}
+
 type Sphere struct {
 	Center Vector3
 	Radius float64
@@ -0,0 +1,316 @@
+package maths
+
+import "math"
+
+// Epsilon is the tolerance used when testing whether a point lies on a zero-width shape.
+const Epsilon = 1e-9
+
+type Segment struct {
+	A Vector2
+	B Vector2
+}
+
+func (s Segment) Length() float64 {
+	return s.A.Distance(s.B)
+}
+
+func (s Segment) Area() float64 {
+	return 0
+}
+
+func (s Segment) Centroid() Vector2 {
+	return s.A.Lerp(s.B, 0.5)
+}
+
+func (s Segment) Bounds() Rectangle {
+	return boundsOf(s.A, s.B)
+}
+
+// ContainsVec reports whether v lies on the segment within Epsilon.
+func (s Segment) ContainsVec(v Vector2) bool {
+	return s.ClosestPoint(v).Distance2(v) <= Epsilon*Epsilon
+}
+
+func (s Segment) ClosestPoint(v Vector2) Vector2 {
+	return s.A.Lerp(s.B, s.ClosestT(v))
+}
+
+// ClosestT returns the parameter in [0, 1] of the closest point on the segment to v.
+func (s Segment) ClosestT(v Vector2) float64 {
+	ab := s.B.Sub(s.A)
+	len2 := ab.Magnitude2()
+	if len2 == 0 {
+		return 0
+	}
+	return math.Max(0, math.Min(1, v.Sub(s.A).Dot(ab)/len2))
+}
+
+// Ray is a half-line starting at Origin. Direction does not need to be normalized.
+type Ray struct {
+	Origin    Vector2
+	Direction Vector2
+}
+
+// At returns the point Origin + Direction * t.
+func (r Ray) At(t float64) Vector2 {
+	return r.Origin.Add(r.Direction.Multiply(t))
+}
+
+// ContainsVec reports whether v lies on the ray within Epsilon.
+func (r Ray) ContainsVec(v Vector2) bool {
+	return r.ClosestPoint(v).Distance2(v) <= Epsilon*Epsilon
+}
+
+func (r Ray) ClosestPoint(v Vector2) Vector2 {
+	len2 := r.Direction.Magnitude2()
+	if len2 == 0 {
+		return r.Origin
+	}
+	t := math.Max(0, v.Sub(r.Origin).Dot(r.Direction)/len2)
+	return r.At(t)
+}
+
+// Capsule is the set of points within Radius of the segment from A to B.
+type Capsule struct {
+	A      Vector2
+	B      Vector2
+	Radius float64
+}
+
+func (c Capsule) Segment() Segment {
+	return Segment{A: c.A, B: c.B}
+}
+
+func (c Capsule) Area() float64 {
+	return math.Pi*c.Radius*c.Radius + 2*c.Radius*c.A.Distance(c.B)
+}
+
+func (c Capsule) Centroid() Vector2 {
+	return c.A.Lerp(c.B, 0.5)
+}
+
+func (c Capsule) Bounds() Rectangle {
+	b := boundsOf(c.A, c.B)
+	return Rectangle{X: b.X - c.Radius, Y: b.Y - c.Radius, Width: b.Width + 2*c.Radius, Height: b.Height + 2*c.Radius}
+}
+
+func (c Capsule) ContainsVec(v Vector2) bool {
+	return c.Segment().ClosestPoint(v).Distance2(v) <= c.Radius*c.Radius
+}
+
+// ClosestPoint returns the closest point in the capsule to v, which is v itself if it is inside.
+func (c Capsule) ClosestPoint(v Vector2) Vector2 {
+	onSegment := c.Segment().ClosestPoint(v)
+	offset := v.Sub(onSegment)
+	if offset.Magnitude2() <= c.Radius*c.Radius {
+		return v
+	}
+	return onSegment.Add(offset.Normalize().Multiply(c.Radius))
+}
+
+// OrientedRect is a rectangle rotated counter-clockwise by Rotation radians around its center.
+type OrientedRect struct {
+	Center      Vector2
+	HalfExtents Vector2
+	Rotation    float64
+}
+
+// Axes returns the unit vectors of the rectangle's local X and Y axes.
+func (o OrientedRect) Axes() (Vector2, Vector2) {
+	sin, cos := math.Sincos(o.Rotation)
+	return Vector2{X: cos, Y: sin}, Vector2{X: -sin, Y: cos}
+}
+
+// Corners returns the corners in counter-clockwise order.
+func (o OrientedRect) Corners() [4]Vector2 {
+	ax, ay := o.Axes()
+	x := ax.Multiply(o.HalfExtents.X)
+	y := ay.Multiply(o.HalfExtents.Y)
+	return [4]Vector2{
+		o.Center.Sub(x).Sub(y),
+		o.Center.Add(x).Sub(y),
+		o.Center.Add(x).Add(y),
+		o.Center.Sub(x).Add(y),
+	}
+}
+
+func (o OrientedRect) Area() float64 {
+	return 4 * o.HalfExtents.X * o.HalfExtents.Y
+}
+
+func (o OrientedRect) Centroid() Vector2 {
+	return o.Center
+}
+
+func (o OrientedRect) Bounds() Rectangle {
+	c := o.Corners()
+	return boundsOf(c[:]...)
+}
+
+func (o OrientedRect) ContainsVec(v Vector2) bool {
+	ax, ay := o.Axes()
+	d := v.Sub(o.Center)
+	return math.Abs(d.Dot(ax)) <= o.HalfExtents.X && math.Abs(d.Dot(ay)) <= o.HalfExtents.Y
+}
+
+// ClosestPoint returns the closest point in the rectangle to v, which is v itself if it is inside.
+func (o OrientedRect) ClosestPoint(v Vector2) Vector2 {
+	if o.ContainsVec(v) {
+		return v
+	}
+	ax, ay := o.Axes()
+	d := v.Sub(o.Center)
+	x := math.Max(-o.HalfExtents.X, math.Min(o.HalfExtents.X, d.Dot(ax)))
+	y := math.Max(-o.HalfExtents.Y, math.Min(o.HalfExtents.Y, d.Dot(ay)))
+	return o.Center.Add(ax.Multiply(x)).Add(ay.Multiply(y))
+}
+
+// ToPolygon returns the rectangle as a counter-clockwise polygon.
+func (o OrientedRect) ToPolygon() Polygon {
+	c := o.Corners()
+	return Polygon{Vertices: c[:]}
+}
+
+type Triangle struct {
+	A Vector2
+	B Vector2
+	C Vector2
+}
+
+func (t Triangle) Area() float64 {
+	return math.Abs(t.B.Sub(t.A).Cross(t.C.Sub(t.A))) / 2
+}
+
+func (t Triangle) Centroid() Vector2 {
+	return t.A.Add(t.B).Add(t.C).Multiply(1.0 / 3)
+}
+
+func (t Triangle) Bounds() Rectangle {
+	return boundsOf(t.A, t.B, t.C)
+}
+
+// ContainsVec reports whether v is inside the triangle or on its edges, for either winding.
+func (t Triangle) ContainsVec(v Vector2) bool {
+	d1 := t.B.Sub(t.A).Cross(v.Sub(t.A))
+	d2 := t.C.Sub(t.B).Cross(v.Sub(t.B))
+	d3 := t.A.Sub(t.C).Cross(v.Sub(t.C))
+	hasNeg := d1 < 0 || d2 < 0 || d3 < 0
+	hasPos := d1 > 0 || d2 > 0 || d3 > 0
+	return !(hasNeg && hasPos)
+}
+
+// ClosestPoint returns the closest point in the triangle to v, which is v itself if it is inside.
+func (t Triangle) ClosestPoint(v Vector2) Vector2 {
+	if t.ContainsVec(v) {
+		return v
+	}
+	return closestOnEdges(v, t.A, t.B, t.C)
+}
+
+func (t Triangle) ToPolygon() Polygon {
+	return Polygon{Vertices: []Vector2{t.A, t.B, t.C}}
+}
+
+// Polygon is a convex polygon. Vertices may be in either winding order.
+type Polygon struct {
+	Vertices []Vector2
+}
+
+// SignedArea is positive when the vertices are counter-clockwise.
+func (p Polygon) SignedArea() float64 {
+	var sum float64
+	for i := range p.Vertices {
+		sum += p.Vertices[i].Cross(p.Vertices[(i+1)%len(p.Vertices)])
+	}
+	return sum / 2
+}
+
+func (p Polygon) Area() float64 {
+	return math.Abs(p.SignedArea())
+}
+
+func (p Polygon) Centroid() Vector2 {
+	area := p.SignedArea()
+	if area == 0 {
+		// degenerate polygon, fall back to the average of the vertices
+		var total Vector2
+		for _, v := range p.Vertices {
+			total = total.Add(v)
+		}
+		if len(p.Vertices) == 0 {
+			return total
+		}
+		return total.Multiply(1 / float64(len(p.Vertices)))
+	}
+
+	var c Vector2
+	for i := range p.Vertices {
+		a := p.Vertices[i]
+		b := p.Vertices[(i+1)%len(p.Vertices)]
+		c = c.Add(a.Add(b).Multiply(a.Cross(b)))
+	}
+	return c.Multiply(1 / (6 * area))
+}
+
+func (p Polygon) Bounds() Rectangle {
+	return boundsOf(p.Vertices...)
+}
+
+// ContainsVec reports whether v is inside the polygon or on its edges.
+func (p Polygon) ContainsVec(v Vector2) bool {
+	if len(p.Vertices) < 3 {
+		return false
+	}
+	var hasNeg, hasPos bool
+	for i := range p.Vertices {
+		a := p.Vertices[i]
+		b := p.Vertices[(i+1)%len(p.Vertices)]
+		d := b.Sub(a).Cross(v.Sub(a))
+		hasNeg = hasNeg || d < 0
+		hasPos = hasPos || d > 0
+		if hasNeg && hasPos {
+			return false
+		}
+	}
+	return true
+}
+
+// ClosestPoint returns the closest point in the polygon to v, which is v itself if it is inside.
+func (p Polygon) ClosestPoint(v Vector2) Vector2 {
+	if p.ContainsVec(v) {
+		return v
+	}
+	return closestOnEdges(v, p.Vertices...)
+}
+
+// Edge returns the segment from vertex i to the next vertex.
+func (p Polygon) Edge(i int) Segment {
+	return Segment{A: p.Vertices[i], B: p.Vertices[(i+1)%len(p.Vertices)]}
+}
+
+// closestOnEdges returns the closest point to v on the closed loop of vertices.
+func closestOnEdges(v Vector2, vertices ...Vector2) Vector2 {
+	var closest Vector2
+	closestDist := math.Inf(1)
+	for i := range vertices {
+		s := Segment{A: vertices[i], B: vertices[(i+1)%len(vertices)]}
+		c := s.ClosestPoint(v)
+		if d := c.Distance2(v); d < closestDist {
+			closest = c
+			closestDist = d
+		}
+	}
+	return closest
+}
+
+func boundsOf(points ...Vector2) Rectangle {
+	if len(points) == 0 {
+		return Rectangle{}
+	}
+	min, max := points[0], points[0]
+	for _, p := range points[1:] {
+		min = min.Min(p)
+		max = max.Max(p)
+	}
+	return Rectangle{X: min.X, Y: min.Y, Width: max.X - min.X, Height: max.Y - min.Y}
+}
@@ -0,0 +1,129 @@
+package maths_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+type shape2D interface {
+	Area() float64
+	Centroid() maths.Vector2
+	Bounds() maths.Rectangle
+	ContainsVec(v maths.Vector2) bool
+	ClosestPoint(v maths.Vector2) maths.Vector2
+}
+
+func TestShapes2D(t *testing.T) {
+	cases := map[string]struct {
+		shape    shape2D
+		area     float64
+		centroid maths.Vector2
+		bounds   maths.Rectangle
+		inside   maths.Vector2
+		outside  maths.Vector2
+		closest  maths.Vector2
+	}{
+		"rectangle": {
+			shape:    maths.Rectangle{X: 0, Y: 0, Width: 4, Height: 2},
+			area:     8,
+			centroid: maths.Vector2{X: 2, Y: 1},
+			bounds:   maths.Rectangle{X: 0, Y: 0, Width: 4, Height: 2},
+			inside:   maths.Vector2{X: 1, Y: 1},
+			outside:  maths.Vector2{X: 5, Y: 5},
+			closest:  maths.Vector2{X: 4, Y: 2},
+		},
+		"circle": {
+			shape:    maths.NewCircle(maths.Vector2{X: 1, Y: 1}, 2),
+			area:     4 * math.Pi,
+			centroid: maths.Vector2{X: 1, Y: 1},
+			bounds:   maths.Rectangle{X: -1, Y: -1, Width: 4, Height: 4},
+			inside:   maths.Vector2{X: 2, Y: 2},
+			outside:  maths.Vector2{X: 1, Y: 5},
+			closest:  maths.Vector2{X: 1, Y: 3},
+		},
+		"segment": {
+			shape:    maths.Segment{A: maths.Vector2{}, B: maths.Vector2{X: 4}},
+			area:     0,
+			centroid: maths.Vector2{X: 2},
+			bounds:   maths.Rectangle{X: 0, Y: 0, Width: 4, Height: 0},
+			inside:   maths.Vector2{X: 3},
+			outside:  maths.Vector2{X: 5, Y: 1},
+			closest:  maths.Vector2{X: 4},
+		},
+		"capsule": {
+			shape:    maths.Capsule{A: maths.Vector2{}, B: maths.Vector2{X: 4}, Radius: 1},
+			area:     math.Pi + 8,
+			centroid: maths.Vector2{X: 2},
+			bounds:   maths.Rectangle{X: -1, Y: -1, Width: 6, Height: 2},
+			inside:   maths.Vector2{X: 2, Y: 0.9},
+			outside:  maths.Vector2{X: 2, Y: 3},
+			closest:  maths.Vector2{X: 2, Y: 1},
+		},
+		"oriented rect": {
+			shape:    maths.OrientedRect{Center: maths.Vector2{X: 1, Y: 1}, HalfExtents: maths.Vector2{X: 2, Y: 1}, Rotation: math.Pi / 2},
+			area:     8,
+			centroid: maths.Vector2{X: 1, Y: 1},
+			bounds:   maths.Rectangle{X: 0, Y: -1, Width: 2, Height: 4},
+			inside:   maths.Vector2{X: 1, Y: 2.5},
+			outside:  maths.Vector2{X: 3, Y: 1},
+			closest:  maths.Vector2{X: 2, Y: 1},
+		},
+		"triangle": {
+			shape:    maths.Triangle{A: maths.Vector2{}, B: maths.Vector2{X: 3}, C: maths.Vector2{Y: 3}},
+			area:     4.5,
+			centroid: maths.Vector2{X: 1, Y: 1},
+			bounds:   maths.Rectangle{X: 0, Y: 0, Width: 3, Height: 3},
+			inside:   maths.Vector2{X: 1, Y: 1},
+			outside:  maths.Vector2{X: 3, Y: 3},
+			closest:  maths.Vector2{X: 1.5, Y: 1.5},
+		},
+		"clockwise polygon": {
+			shape: maths.Polygon{Vertices: []maths.Vector2{
+				{X: 0, Y: 0}, {X: 0, Y: 2}, {X: 2, Y: 2}, {X: 2, Y: 0},
+			}},
+			area:     4,
+			centroid: maths.Vector2{X: 1, Y: 1},
+			bounds:   maths.Rectangle{X: 0, Y: 0, Width: 2, Height: 2},
+			inside:   maths.Vector2{X: 0.5, Y: 1.5},
+			outside:  maths.Vector2{X: -1, Y: 1},
+			closest:  maths.Vector2{X: 0, Y: 1},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, c.area, c.shape.Area(), 1e-9)
+			assert.True(t, c.centroid.ApproxEqual(c.shape.Centroid(), 1e-9))
+			bounds := c.shape.Bounds()
+			assert.InDelta(t, c.bounds.X, bounds.X, 1e-9)
+			assert.InDelta(t, c.bounds.Y, bounds.Y, 1e-9)
+			assert.InDelta(t, c.bounds.Width, bounds.Width, 1e-9)
+			assert.InDelta(t, c.bounds.Height, bounds.Height, 1e-9)
+			assert.True(t, c.shape.ContainsVec(c.inside))
+			assert.False(t, c.shape.ContainsVec(c.outside))
+			assert.Equal(t, c.inside, c.shape.ClosestPoint(c.inside))
+			assert.True(t, c.closest.ApproxEqual(c.shape.ClosestPoint(c.outside), 1e-9), "%v", c.shape.ClosestPoint(c.outside))
+		})
+	}
+}
+
+func TestRay_ClosestPoint(t *testing.T) {
+	r := maths.Ray{Origin: maths.Vector2{X: 1}, Direction: maths.Vector2{X: 2}}
+	assert.Equal(t, maths.Vector2{X: 5}, r.At(2))
+	assert.Equal(t, maths.Vector2{X: 1}, r.ClosestPoint(maths.Vector2{X: -5, Y: 1}))
+	assert.Equal(t, maths.Vector2{X: 10}, r.ClosestPoint(maths.Vector2{X: 10, Y: 3}))
+	assert.True(t, r.ContainsVec(maths.Vector2{X: 100}))
+	assert.False(t, r.ContainsVec(maths.Vector2{X: 0}))
+}
+
+func TestPolygon_Centroid(t *testing.T) {
+	triangle := maths.Triangle{A: maths.Vector2{X: 1}, B: maths.Vector2{X: 5, Y: 1}, C: maths.Vector2{X: 2, Y: 7}}
+	assert.True(t, triangle.Centroid().ApproxEqual(triangle.ToPolygon().Centroid(), 1e-9))
+	assert.InDelta(t, triangle.Area(), triangle.ToPolygon().Area(), 1e-9)
+
+	degenerate := maths.Polygon{Vertices: []maths.Vector2{{X: 0}, {X: 2}}}
+	assert.Equal(t, maths.Vector2{X: 1}, degenerate.Centroid())
+	assert.False(t, degenerate.ContainsVec(maths.Vector2{X: 1}))
+}
@@ -0,0 +1,161 @@
+package maths
+
+import "math"
+
+// Box is an axis aligned box in 3D space.
+type Box struct {
+	X      float64
+	Y      float64
+	Z      float64
+	Width  float64
+	Height float64
+	Depth  float64
+}
+
+func (b Box) Min() Vector3 {
+	return Vector3{X: b.X, Y: b.Y, Z: b.Z}
+}
+
+func (b Box) Max() Vector3 {
+	return Vector3{X: b.X + b.Width, Y: b.Y + b.Height, Z: b.Z + b.Depth}
+}
+
+func (b Box) Volume() float64 {
+	return b.Width * b.Height * b.Depth
+}
+
+func (b Box) Centroid() Vector3 {
+	return Vector3{X: b.X + b.Width/2, Y: b.Y + b.Height/2, Z: b.Z + b.Depth/2}
+}
+
+func (b Box) Bounds() Box {
+	return b
+}
+
+func (b Box) ContainsVec(v Vector3) bool {
+	return b.X <= v.X && b.Y <= v.Y && b.Z <= v.Z && b.X+b.Width > v.X && b.Y+b.Height > v.Y && b.Z+b.Depth > v.Z
+}
+
+func (b Box) ContainsBox(b2 Box) bool {
+	return b.X <= b2.X && b.X+b.Width >= b2.X+b2.Width &&
+		b.Y <= b2.Y && b.Y+b.Height >= b2.Y+b2.Height &&
+		b.Z <= b2.Z && b.Z+b.Depth >= b2.Z+b2.Depth
+}
+
+func (b Box) ContainsSphere(s Sphere) bool {
+	return b.X <= s.Center.X-s.Radius && b.X+b.Width >= s.Center.X+s.Radius &&
+		b.Y <= s.Center.Y-s.Radius && b.Y+b.Height >= s.Center.Y+s.Radius &&
+		b.Z <= s.Center.Z-s.Radius && b.Z+b.Depth >= s.Center.Z+s.Radius
+}
+
+func (b Box) IntersectsBox(b2 Box) bool {
+	return !(b2.X >= b.X+b.Width || b2.X+b2.Width <= b.X ||
+		b2.Y >= b.Y+b.Height || b2.Y+b2.Height <= b.Y ||
+		b2.Z >= b.Z+b.Depth || b2.Z+b2.Depth <= b.Z)
+}
+
+func (b Box) IntersectsSphere(s Sphere) bool {
+	return b.ClosestPoint(s.Center).Distance2(s.Center) < s.Radius*s.Radius
+}
+
+// ClosestPoint returns the closest point in the box to v, which is v itself if it is inside.
+func (b Box) ClosestPoint(v Vector3) Vector3 {
+	return v.Max(b.Min()).Min(b.Max())
+}
+
+func (s Sphere) Volume() float64 {
+	return 4.0 / 3 * math.Pi * s.Radius * s.Radius * s.Radius
+}
+
+func (s Sphere) Centroid() Vector3 {
+	return s.Center
+}
+
+func (s Sphere) Bounds() Box {
+	return Box{
+		X: s.Center.X - s.Radius, Y: s.Center.Y - s.Radius, Z: s.Center.Z - s.Radius,
+		Width: s.Radius * 2, Height: s.Radius * 2, Depth: s.Radius * 2,
+	}
+}
+
+func (s Sphere) ContainsVec(v Vector3) bool {
+	return s.Center.Distance2(v) <= s.Radius*s.Radius
+}
+
+// ContainsBox reports whether every corner of the box is inside the sphere.
+func (s Sphere) ContainsBox(b Box) bool {
+	// the furthest corner from the center is the furthest point of the box
+	far := Vector3{
+		X: math.Max(math.Abs(b.X-s.Center.X), math.Abs(b.X+b.Width-s.Center.X)),
+		Y: math.Max(math.Abs(b.Y-s.Center.Y), math.Abs(b.Y+b.Height-s.Center.Y)),
+		Z: math.Max(math.Abs(b.Z-s.Center.Z), math.Abs(b.Z+b.Depth-s.Center.Z)),
+	}
+	return far.Magnitude2() <= s.Radius*s.Radius
+}
+
+func (s Sphere) IntersectsBox(b Box) bool {
+	return b.IntersectsSphere(s)
+}
+
+// ClosestPoint returns the closest point in the sphere to v, which is v itself if it is inside.
+func (s Sphere) ClosestPoint(v Vector3) Vector3 {
+	offset := v.Sub(s.Center)
+	if offset.Magnitude2() <= s.Radius*s.Radius {
+		return v
+	}
+	return s.Center.Add(offset.Normalize().Multiply(s.Radius))
+}
+
+// Shape3D is a 3D shape that can be tested against any other Shape3D.
+// As with Shape, shapes that only touch do not intersect.
+type Shape3D interface {
+	Bounds() Box
+	ContainsVec(v Vector3) bool
+	ClosestPoint(v Vector3) Vector3
+	shape3D()
+}
+
+func (b Box) shape3D()    {}
+func (s Sphere) shape3D() {}
+
+// Intersects3D reports whether two shapes overlap.
+func Intersects3D(a, b Shape3D) bool {
+	switch a := a.(type) {
+	case Box:
+		switch b := b.(type) {
+		case Box:
+			return a.IntersectsBox(b)
+		case Sphere:
+			return a.IntersectsSphere(b)
+		}
+	case Sphere:
+		switch b := b.(type) {
+		case Box:
+			return a.IntersectsBox(b)
+		case Sphere:
+			return a.IntersectsSphere(b)
+		}
+	}
+	return false
+}
+
+// Contains3D reports whether b lies entirely inside a.
+func Contains3D(a, b Shape3D) bool {
+	switch a := a.(type) {
+	case Box:
+		switch b := b.(type) {
+		case Box:
+			return a.ContainsBox(b)
+		case Sphere:
+			return a.ContainsSphere(b)
+		}
+	case Sphere:
+		switch b := b.(type) {
+		case Box:
+			return a.ContainsBox(b)
+		case Sphere:
+			return a.ContainsSphere(b)
+		}
+	}
+	return false
+}
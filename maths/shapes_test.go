@@ -262,3 +262,95 @@ func BenchmarkSphere_IntersectsSphere(b *testing.B) {
 		})
 	}
 }
+
+func TestRectangle_Intersection(t *testing.T) {
+	a := maths.Rectangle{X: 0, Y: 0, Width: 4, Height: 4}
+
+	r, ok := a.Intersection(maths.Rectangle{X: 2, Y: 1, Width: 4, Height: 2})
+	assert.True(t, ok)
+	assert.Equal(t, maths.Rectangle{X: 2, Y: 1, Width: 2, Height: 2}, r)
+
+	_, ok = a.Intersection(maths.Rectangle{X: 4, Y: 0, Width: 1, Height: 1})
+	assert.False(t, ok, "touching edges have no area")
+}
+
+func TestRectangle_Union(t *testing.T) {
+	a := maths.Rectangle{X: 0, Y: 0, Width: 1, Height: 1}
+	assert.Equal(t, maths.Rectangle{X: -1, Y: 0, Width: 5, Height: 3}, a.Union(maths.Rectangle{X: -1, Y: 2, Width: 5, Height: 1}))
+}
+
+func TestRectangle_Subtract(t *testing.T) {
+	a := maths.Rectangle{X: 0, Y: 0, Width: 4, Height: 4}
+
+	cases := map[string]struct {
+		cut      maths.Rectangle
+		expected []maths.Rectangle
+	}{
+		"disjoint": {
+			cut:      maths.Rectangle{X: 5, Y: 5, Width: 1, Height: 1},
+			expected: []maths.Rectangle{a},
+		},
+		"hole": {
+			cut: maths.Rectangle{X: 1, Y: 1, Width: 2, Height: 2},
+			expected: []maths.Rectangle{
+				{X: 0, Y: 0, Width: 4, Height: 1},
+				{X: 0, Y: 3, Width: 4, Height: 1},
+				{X: 0, Y: 1, Width: 1, Height: 2},
+				{X: 3, Y: 1, Width: 1, Height: 2},
+			},
+		},
+		"corner": {
+			cut: maths.Rectangle{X: 2, Y: 2, Width: 4, Height: 4},
+			expected: []maths.Rectangle{
+				{X: 0, Y: 0, Width: 4, Height: 2},
+				{X: 0, Y: 2, Width: 2, Height: 2},
+			},
+		},
+		"covered": {
+			cut:      maths.Rectangle{X: -1, Y: -1, Width: 6, Height: 6},
+			expected: nil,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var results []maths.Rectangle
+			a.Subtract(&results, c.cut)
+			assert.Equal(t, c.expected, results)
+
+			area := 0.0
+			for _, r := range results {
+				area += r.Area()
+			}
+			overlap, _ := a.Intersection(c.cut)
+			assert.InDelta(t, a.Area()-overlap.Area(), area, 1e-9)
+		})
+	}
+}
+
+func TestMergeAll(t *testing.T) {
+	// an L shape of tiles with a separate tile off to the side
+	tiles := []string{
+		"#...#",
+		"#....",
+		"###..",
+		"###..",
+	}
+	var rects []maths.Rectangle
+	for y, row := range tiles {
+		for x, c := range row {
+			if c == '#' {
+				rects = append(rects, maths.Rectangle{X: float64(x), Y: float64(y), Width: 1, Height: 1})
+			}
+		}
+	}
+
+	merged := maths.MergeAll(rects)
+	assert.ElementsMatch(t, []maths.Rectangle{
+		{X: 0, Y: 0, Width: 1, Height: 2},
+		{X: 0, Y: 2, Width: 3, Height: 2},
+		{X: 4, Y: 0, Width: 1, Height: 1},
+	}, merged)
+
+	assert.Nil(t, maths.MergeAll(nil))
+}
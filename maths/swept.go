@@ -0,0 +1,325 @@
+package maths
+
+import "math"
+
+// Hit describes the first moment a moving shape touches another.
+// Time is the fraction of the movement completed at impact, or for a ray cast the multiple of
+// Direction travelled. Normal is the surface normal of the shape that was hit, and Point is where
+// the shapes touch.
+//
+// Sweeps ignore hits where the moving shape is already moving away from the other, so shapes
+// that start overlapped can separate.
+type Hit struct {
+	Time   float64
+	Normal Vector2
+	Point  Vector2
+}
+
+// CastCircle returns the first point along the ray that enters the circle.
+func (r Ray) CastCircle(c Circle) (Hit, bool) {
+	m := r.Origin.Sub(c.Center)
+	cc := m.Magnitude2() - c.Radius*c.Radius
+	if cc <= 0 {
+		// starting inside
+		return Hit{Time: 0, Normal: m.Normalize(), Point: r.Origin}, true
+	}
+
+	a := r.Direction.Magnitude2()
+	b := m.Dot(r.Direction)
+	if a == 0 || b >= 0 {
+		// not moving or moving away
+		return Hit{}, false
+	}
+	disc := b*b - a*cc
+	if disc < 0 {
+		return Hit{}, false
+	}
+
+	t := (-b - math.Sqrt(disc)) / a
+	p := r.At(t)
+	return Hit{Time: t, Normal: p.Sub(c.Center).Normalize(), Point: p}, true
+}
+
+// CastRectangle returns the first point along the ray that enters the rectangle.
+func (r Ray) CastRectangle(rect Rectangle) (Hit, bool) {
+	tEnter, tExit := math.Inf(-1), math.Inf(1)
+	var normal Vector2
+
+	axes := [2]struct {
+		origin, dir, min, max float64
+		axis                  Vector2
+	}{
+		{r.Origin.X, r.Direction.X, rect.X, rect.X + rect.Width, Vector2{X: 1}},
+		{r.Origin.Y, r.Direction.Y, rect.Y, rect.Y + rect.Height, Vector2{Y: 1}},
+	}
+	for _, a := range axes {
+		if a.dir == 0 {
+			if a.origin < a.min || a.origin > a.max {
+				return Hit{}, false
+			}
+			continue
+		}
+
+		t1 := (a.min - a.origin) / a.dir
+		t2 := (a.max - a.origin) / a.dir
+		n := a.axis.Multiply(-1)
+		if t1 > t2 {
+			t1, t2 = t2, t1
+			n = a.axis
+		}
+		if t1 > tEnter {
+			tEnter = t1
+			normal = n
+		}
+		tExit = math.Min(tExit, t2)
+	}
+
+	if tEnter > tExit || tExit < 0 {
+		return Hit{}, false
+	}
+	if tEnter < 0 {
+		// starting inside, push out of the nearest face
+		return Hit{Time: 0, Normal: nearestFaceNormal(rect, r.Origin), Point: r.Origin}, true
+	}
+	return Hit{Time: tEnter, Normal: normal, Point: r.At(tEnter)}, true
+}
+
+// nearestFaceNormal returns the outward normal of the rectangle face closest to v.
+func nearestFaceNormal(rect Rectangle, v Vector2) Vector2 {
+	normal := Vector2{X: -1}
+	dist := v.X - rect.X
+	if d := rect.X + rect.Width - v.X; d < dist {
+		normal, dist = Vector2{X: 1}, d
+	}
+	if d := v.Y - rect.Y; d < dist {
+		normal, dist = Vector2{Y: -1}, d
+	}
+	if d := rect.Y + rect.Height - v.Y; d < dist {
+		normal = Vector2{Y: 1}
+	}
+	return normal
+}
+
+// CastSegment returns the point where the ray crosses the segment.
+func (r Ray) CastSegment(s Segment) (Hit, bool) {
+	e := s.B.Sub(s.A)
+	denom := r.Direction.Cross(e)
+	if denom == 0 {
+		return Hit{}, false
+	}
+	ao := s.A.Sub(r.Origin)
+	t := ao.Cross(e) / denom
+	u := ao.Cross(r.Direction) / denom
+	if t < 0 || u < 0 || u > 1 {
+		return Hit{}, false
+	}
+
+	normal := e.Perpendicular().Normalize()
+	if normal.Dot(r.Direction) > 0 {
+		normal = normal.Multiply(-1)
+	}
+	return Hit{Time: t, Normal: normal, Point: r.At(t)}, true
+}
+
+// CastCapsule returns the first point along the ray that enters the capsule.
+func (r Ray) CastCapsule(c Capsule) (Hit, bool) {
+	seg := c.Segment()
+	closest := seg.ClosestPoint(r.Origin)
+	if closest.Distance2(r.Origin) <= c.Radius*c.Radius {
+		return Hit{Time: 0, Normal: r.Origin.Sub(closest).Normalize(), Point: r.Origin}, true
+	}
+
+	// the capsule is the union of the end circles and the band between the offset sides
+	best := Hit{Time: math.Inf(1)}
+	found := false
+	consider := func(h Hit, ok bool) {
+		if ok && h.Time < best.Time {
+			best = h
+			found = true
+		}
+	}
+	consider(r.CastCircle(Circle{Center: c.A, Radius: c.Radius}))
+	consider(r.CastCircle(Circle{Center: c.B, Radius: c.Radius}))
+	offset := c.B.Sub(c.A).Perpendicular().Normalize().Multiply(c.Radius)
+	consider(r.CastSegment(Segment{A: c.A.Add(offset), B: c.B.Add(offset)}))
+	consider(r.CastSegment(Segment{A: c.A.Sub(offset), B: c.B.Sub(offset)}))
+	return best, found
+}
+
+// SweepRectangle moves a by delta and returns the first time it touches b.
+func SweepRectangle(a Rectangle, delta Vector2, b Rectangle) (Hit, bool) {
+	expanded := Rectangle{X: b.X - a.Width, Y: b.Y - a.Height, Width: b.Width + a.Width, Height: b.Height + a.Height}
+	hit, ok := Ray{Origin: Vector2{X: a.X, Y: a.Y}, Direction: delta}.CastRectangle(expanded)
+	if !ok || !validSweepHit(hit, delta) {
+		return Hit{}, false
+	}
+
+	// the contact point is the middle of the region the moved rectangle shares with b
+	moved := a
+	moved.X += delta.X * hit.Time
+	moved.Y += delta.Y * hit.Time
+	min := Vector2{X: math.Max(moved.X, b.X), Y: math.Max(moved.Y, b.Y)}
+	max := Vector2{X: math.Min(moved.X+moved.Width, b.X+b.Width), Y: math.Min(moved.Y+moved.Height, b.Y+b.Height)}
+	hit.Point = min.Lerp(max, 0.5)
+	return hit, true
+}
+
+// SweepRectanglePolygon moves a by delta and returns the first time it touches the convex polygon b.
+func SweepRectanglePolygon(a Rectangle, delta Vector2, b Polygon) (Hit, bool) {
+	// the Minkowski difference of two convex shapes is bounded by the edge normals of both,
+	// so the ray from the rectangle's corner is clipped against one half-plane per normal
+	vertices := counterClockwise(b.Vertices)
+	corners := a.ToPolygon().Vertices
+
+	normals := make([]Vector2, 0, len(vertices)+4)
+	normals = append(normals, Vector2{X: 1}, Vector2{X: -1}, Vector2{Y: 1}, Vector2{Y: -1})
+	for i := range vertices {
+		normals = append(normals, edgeNormal(vertices, i))
+	}
+
+	tEnter, tExit := math.Inf(-1), math.Inf(1)
+	var enterNormal, insideNormal Vector2
+	insideDist := math.Inf(-1)
+	for _, n := range normals {
+		// how far a is in front of b along n, negative while their projections overlap
+		_, maxB := projectOnto(vertices, n)
+		minA, _ := projectOnto(corners, n)
+		dist := minA - maxB
+		if dist > insideDist {
+			insideDist, insideNormal = dist, n
+		}
+
+		denom := n.Dot(delta)
+		if denom == 0 {
+			if dist > 0 {
+				return Hit{}, false
+			}
+			continue
+		}
+		t := -dist / denom
+		if denom < 0 {
+			if t > tEnter {
+				tEnter, enterNormal = t, n
+			}
+		} else {
+			tExit = math.Min(tExit, t)
+		}
+	}
+
+	if tEnter > tExit || tExit < 0 {
+		return Hit{}, false
+	}
+	hit := Hit{Time: tEnter, Normal: enterNormal}
+	if tEnter < 0 {
+		// starting inside, push out along the axis of least penetration
+		hit = Hit{Time: 0, Normal: insideNormal}
+	}
+	if !validSweepHit(hit, delta) {
+		return Hit{}, false
+	}
+
+	moved := a
+	moved.X += delta.X * hit.Time
+	moved.Y += delta.Y * hit.Time
+	hit.Point = b.ClosestPoint(moved.Centroid())
+	return hit, true
+}
+
+// SweepCircleCircle moves a by delta and returns the first time it touches b.
+func SweepCircleCircle(a Circle, delta Vector2, b Circle) (Hit, bool) {
+	hit, ok := Ray{Origin: a.Center, Direction: delta}.CastCircle(Circle{Center: b.Center, Radius: a.Radius + b.Radius})
+	if !ok || !validSweepHit(hit, delta) {
+		return Hit{}, false
+	}
+	hit.Point = hit.Point.Sub(hit.Normal.Multiply(a.Radius))
+	return hit, true
+}
+
+// SweepCircleRectangle moves a by delta and returns the first time it touches b.
+func SweepCircleRectangle(a Circle, delta Vector2, b Rectangle) (Hit, bool) {
+	ray := Ray{Origin: a.Center, Direction: delta}
+	r := a.Radius
+
+	var hit Hit
+	var ok bool
+	if closest := b.ClosestPoint(a.Center); closest.Distance2(a.Center) <= r*r {
+		// starting overlapped, push out of the nearest surface
+		m, overlapping := CircleRectangleContact(a, b)
+		if !overlapping {
+			// only touching, so push away from the closest point
+			m.Normal = closest.Sub(a.Center).Normalize()
+		}
+		hit, ok = Hit{Time: 0, Normal: m.Normal.Multiply(-1), Point: a.Center}, true
+	} else {
+		// the rectangle grown by the radius is the union of two stretched rectangles and four corner circles
+		hit = Hit{Time: math.Inf(1)}
+		consider := func(h Hit, found bool) {
+			if found && h.Time < hit.Time {
+				hit = h
+				ok = true
+			}
+		}
+		consider(ray.CastRectangle(Rectangle{X: b.X - r, Y: b.Y, Width: b.Width + 2*r, Height: b.Height}))
+		consider(ray.CastRectangle(Rectangle{X: b.X, Y: b.Y - r, Width: b.Width, Height: b.Height + 2*r}))
+		for _, corner := range b.ToPolygon().Vertices {
+			consider(ray.CastCircle(Circle{Center: corner, Radius: r}))
+		}
+	}
+
+	if !ok || !validSweepHit(hit, delta) {
+		return Hit{}, false
+	}
+	hit.Point = hit.Point.Sub(hit.Normal.Multiply(r))
+	return hit, true
+}
+
+// SweepCircleSegment moves a by delta and returns the first time it touches s.
+func SweepCircleSegment(a Circle, delta Vector2, s Segment) (Hit, bool) {
+	hit, ok := Ray{Origin: a.Center, Direction: delta}.CastCapsule(Capsule{A: s.A, B: s.B, Radius: a.Radius})
+	if !ok || !validSweepHit(hit, delta) {
+		return Hit{}, false
+	}
+	hit.Point = hit.Point.Sub(hit.Normal.Multiply(a.Radius))
+	return hit, true
+}
+
+// validSweepHit rejects hits beyond the end of the movement and hits that the movement is leaving.
+func validSweepHit(hit Hit, delta Vector2) bool {
+	return hit.Time <= 1 && hit.Normal.Dot(delta) < 0
+}
+
+// Hit3D describes the first moment a moving 3D shape touches another, in the same way as Hit.
+type Hit3D struct {
+	Time   float64
+	Normal Vector3
+	Point  Vector3
+}
+
+// SweepSphereSphere moves a by delta and returns the first time it touches b.
+func SweepSphereSphere(a Sphere, delta Vector3, b Sphere) (Hit3D, bool) {
+	m := a.Center.Sub(b.Center)
+	radius := a.Radius + b.Radius
+	cc := m.Magnitude2() - radius*radius
+	bb := m.Dot(delta)
+	if bb >= 0 {
+		// not approaching
+		return Hit3D{}, false
+	}
+
+	var t float64
+	if cc > 0 {
+		aa := delta.Magnitude2()
+		disc := bb*bb - aa*cc
+		if disc < 0 {
+			return Hit3D{}, false
+		}
+		t = (-bb - math.Sqrt(disc)) / aa
+		if t > 1 {
+			return Hit3D{}, false
+		}
+	}
+
+	center := a.Center.Add(delta.Multiply(t))
+	normal := center.Sub(b.Center).Normalize()
+	return Hit3D{Time: t, Normal: normal, Point: center.Sub(normal.Multiply(a.Radius))}, true
+}
@@ -0,0 +1,162 @@
+package maths_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestSweepRectangle(t *testing.T) {
+	wall := maths.Rectangle{X: 10, Y: -5, Width: 0.1, Height: 10}
+	bullet := maths.Rectangle{X: 0, Y: 0, Width: 1, Height: 1}
+
+	hit, ok := maths.SweepRectangle(bullet, maths.Vector2{X: 20}, wall)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.45, hit.Time, 1e-9)
+	assert.Equal(t, maths.Vector2{X: -1}, hit.Normal)
+	assert.Equal(t, maths.Vector2{X: 10, Y: 0.5}, hit.Point)
+
+	_, ok = maths.SweepRectangle(bullet, maths.Vector2{X: 5}, wall)
+	assert.False(t, ok, "stops short")
+	_, ok = maths.SweepRectangle(bullet, maths.Vector2{X: -20}, wall)
+	assert.False(t, ok, "moving away")
+	_, ok = maths.SweepRectangle(bullet, maths.Vector2{X: 20, Y: 20}, wall)
+	assert.False(t, ok, "passes above")
+
+	t.Run("overlapping", func(t *testing.T) {
+		inside := maths.Rectangle{X: 9.2, Y: 0, Width: 1, Height: 1}
+		hit, ok := maths.SweepRectangle(inside, maths.Vector2{X: 1}, wall)
+		assert.True(t, ok)
+		assert.Equal(t, 0.0, hit.Time)
+		assert.Equal(t, maths.Vector2{X: -1}, hit.Normal)
+
+		_, ok = maths.SweepRectangle(inside, maths.Vector2{X: -1}, wall)
+		assert.False(t, ok, "separating")
+	})
+}
+
+func TestSweepCircleCircle(t *testing.T) {
+	hit, ok := maths.SweepCircleCircle(maths.NewCircle(maths.Vector2{}, 1), maths.Vector2{X: 10}, maths.NewCircle(maths.Vector2{X: 5}, 1))
+	assert.True(t, ok)
+	assert.InDelta(t, 0.3, hit.Time, 1e-9)
+	assertVector2InDelta(t, maths.Vector2{X: -1}, hit.Normal, 1e-9)
+	assertVector2InDelta(t, maths.Vector2{X: 4}, hit.Point, 1e-9)
+
+	_, ok = maths.SweepCircleCircle(maths.NewCircle(maths.Vector2{}, 1), maths.Vector2{X: 10}, maths.NewCircle(maths.Vector2{X: 5, Y: 2.5}, 1))
+	assert.False(t, ok)
+}
+
+func TestSweepCircleRectangle(t *testing.T) {
+	rect := maths.Rectangle{X: 0, Y: 0, Width: 2, Height: 2}
+
+	cases := map[string]struct {
+		circle maths.Circle
+		delta  maths.Vector2
+		time   float64
+		normal maths.Vector2
+		hit    bool
+	}{
+		"face": {
+			circle: maths.NewCircle(maths.Vector2{X: -3, Y: 1}, 1),
+			delta:  maths.Vector2{X: 4},
+			time:   0.5,
+			normal: maths.Vector2{X: -1},
+			hit:    true,
+		},
+		"corner": {
+			circle: maths.NewCircle(maths.Vector2{X: -3, Y: 5}, 1),
+			delta:  maths.Vector2{X: 3, Y: -3},
+			time:   1 - 1/(3*math.Sqrt2),
+			normal: maths.Vector2{X: -1, Y: 1}.Normalize(),
+			hit:    true,
+		},
+		"starts touching": {
+			circle: maths.NewCircle(maths.Vector2{X: -1, Y: 1}, 1),
+			delta:  maths.Vector2{X: 1},
+			time:   0,
+			normal: maths.Vector2{X: -1},
+			hit:    true,
+		},
+		"misses corner": {
+			circle: maths.NewCircle(maths.Vector2{X: -2, Y: 3.5}, 1),
+			delta:  maths.Vector2{X: 6},
+			hit:    false,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			hit, ok := maths.SweepCircleRectangle(c.circle, c.delta, rect)
+			assert.Equal(t, c.hit, ok)
+			if c.hit {
+				assert.InDelta(t, c.time, hit.Time, 1e-9)
+				assertVector2InDelta(t, c.normal, hit.Normal, 1e-9)
+			}
+		})
+	}
+}
+
+func TestSweepCircleSegment(t *testing.T) {
+	wall := maths.Segment{A: maths.Vector2{X: 5, Y: -5}, B: maths.Vector2{X: 5, Y: 5}}
+
+	hit, ok := maths.SweepCircleSegment(maths.NewCircle(maths.Vector2{}, 1), maths.Vector2{X: 8}, wall)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.5, hit.Time, 1e-9)
+	assertVector2InDelta(t, maths.Vector2{X: -1}, hit.Normal, 1e-9)
+	assertVector2InDelta(t, maths.Vector2{X: 5}, hit.Point, 1e-9)
+
+	// glancing off the end cap
+	hit, ok = maths.SweepCircleSegment(maths.NewCircle(maths.Vector2{Y: 5.5}, 1), maths.Vector2{X: 10}, wall)
+	assert.True(t, ok)
+	assert.Less(t, hit.Normal.Y, 1.0)
+	assert.Greater(t, hit.Normal.Y, 0.0)
+
+	_, ok = maths.SweepCircleSegment(maths.NewCircle(maths.Vector2{Y: 7}, 1), maths.Vector2{X: 10}, wall)
+	assert.False(t, ok)
+}
+
+func TestSweepSphereSphere(t *testing.T) {
+	hit, ok := maths.SweepSphereSphere(maths.NewSphere(maths.Vector3{}, 1), maths.Vector3{Z: 10}, maths.NewSphere(maths.Vector3{Z: 6}, 1))
+	assert.True(t, ok)
+	assert.InDelta(t, 0.4, hit.Time, 1e-9)
+	assert.Equal(t, maths.Vector3{Z: -1}, hit.Normal)
+	assert.Equal(t, maths.Vector3{Z: 5}, hit.Point)
+
+	_, ok = maths.SweepSphereSphere(maths.NewSphere(maths.Vector3{}, 1), maths.Vector3{Z: -10}, maths.NewSphere(maths.Vector3{Z: 6}, 1))
+	assert.False(t, ok)
+}
+
+func TestRay_CastRectangle(t *testing.T) {
+	ray := maths.Ray{Origin: maths.Vector2{X: -1, Y: 1}, Direction: maths.Vector2{X: 1}}
+	hit, ok := ray.CastRectangle(maths.Rectangle{X: 2, Y: 0, Width: 2, Height: 2})
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, hit.Time)
+	assert.Equal(t, maths.Vector2{X: 2, Y: 1}, hit.Point)
+
+	_, ok = ray.CastRectangle(maths.Rectangle{X: -4, Y: 0, Width: 2, Height: 2})
+	assert.False(t, ok)
+}
+
+func TestSweepRectanglePolygon(t *testing.T) {
+	// a ramp rising to the right
+	ramp := maths.Polygon{Vertices: []maths.Vector2{{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 4, Y: 4}}}
+	box := maths.Rectangle{X: 1, Y: 5, Width: 1, Height: 1}
+
+	hit, ok := maths.SweepRectanglePolygon(box, maths.Vector2{Y: -5}, ramp)
+	assert.True(t, ok)
+	// the bottom right corner lands on the slope at (2, 2)
+	assert.InDelta(t, 0.6, hit.Time, 1e-9)
+	assertVector2InDelta(t, maths.Vector2{X: -1, Y: 1}.Normalize(), hit.Normal, 1e-9)
+
+	hit, ok = maths.SweepRectanglePolygon(maths.Rectangle{X: -3, Y: 1, Width: 1, Height: 1}, maths.Vector2{X: 10}, ramp)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.3, hit.Time, 1e-9)
+	assertVector2InDelta(t, maths.Vector2{X: -1, Y: 1}.Normalize(), hit.Normal, 1e-9)
+
+	_, ok = maths.SweepRectanglePolygon(maths.Rectangle{X: 5, Y: 1, Width: 1, Height: 1}, maths.Vector2{Y: -5}, ramp)
+	assert.False(t, ok)
+
+	_, ok = maths.SweepRectanglePolygon(maths.Rectangle{X: 1, Y: 5, Width: 1, Height: 1}, maths.Vector2{Y: 5}, ramp)
+	assert.False(t, ok, "moving away")
+}
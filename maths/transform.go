@@ -0,0 +1,45 @@
+package maths
+
+// Transform2D is a position, rotation and scale in 2D space.
+// Rotation is counter-clockwise in radians.
+type Transform2D struct {
+	Position Vector2
+	Rotation float64
+	Scale    Vector2
+}
+
+func NewTransform2D(position Vector2) Transform2D {
+	return Transform2D{
+		Position: position,
+		Scale:    Vector2{X: 1, Y: 1},
+	}
+}
+
+// Matrix returns the matrix that scales, then rotates, then translates.
+func (t Transform2D) Matrix() Matrix3 {
+	return NewTranslationMatrix3(t.Position).
+		Multiply(NewRotationMatrix3(t.Rotation)).
+		Multiply(NewScaleMatrix3(t.Scale))
+}
+
+// Transform3D is a position, rotation and scale in 3D space.
+type Transform3D struct {
+	Position Vector3
+	Rotation Quaternion
+	Scale    Vector3
+}
+
+func NewTransform3D(position Vector3) Transform3D {
+	return Transform3D{
+		Position: position,
+		Rotation: IdentityQuaternion(),
+		Scale:    Vector3{X: 1, Y: 1, Z: 1},
+	}
+}
+
+// Matrix returns the matrix that scales, then rotates, then translates.
+func (t Transform3D) Matrix() Matrix4 {
+	return NewTranslationMatrix4(t.Position).
+		Multiply(t.Rotation.ToMatrix4()).
+		Multiply(NewScaleMatrix4(t.Scale))
+}
@@ -0,0 +1,196 @@
+package maths
+
+import "math"
+
+// Vec2 is a 2D vector over any numeric type.
+// Methods that can produce fractional results return float64.
+type Vec2[T Number] struct {
+	X T
+	Y T
+}
+
+func (v Vec2[T]) Add(v2 Vec2[T]) Vec2[T] {
+	v.X += v2.X
+	v.Y += v2.Y
+	return v
+}
+
+func (v Vec2[T]) Sub(v2 Vec2[T]) Vec2[T] {
+	v.X -= v2.X
+	v.Y -= v2.Y
+	return v
+}
+
+func (v Vec2[T]) Multiply(scalar T) Vec2[T] {
+	v.X *= scalar
+	v.Y *= scalar
+	return v
+}
+
+func (v Vec2[T]) Divide(scalar T) Vec2[T] {
+	v.X /= scalar
+	v.Y /= scalar
+	return v
+}
+
+func (v Vec2[T]) Dot(v2 Vec2[T]) T {
+	return v.X*v2.X + v.Y*v2.Y
+}
+
+// Cross returns the z component of the 3D cross product.
+func (v Vec2[T]) Cross(v2 Vec2[T]) T {
+	return v.X*v2.Y - v.Y*v2.X
+}
+
+func (v Vec2[T]) Magnitude() float64 {
+	return math.Sqrt(float64(v.Magnitude2()))
+}
+
+func (v Vec2[T]) Magnitude2() T {
+	return v.X*v.X + v.Y*v.Y
+}
+
+func (v Vec2[T]) Distance(v2 Vec2[T]) float64 {
+	return v.Sub(v2).Magnitude()
+}
+
+func (v Vec2[T]) Distance2(v2 Vec2[T]) T {
+	return v.Sub(v2).Magnitude2()
+}
+
+func (v Vec2[T]) Min(v2 Vec2[T]) Vec2[T] {
+	return Vec2[T]{X: minNumber(v.X, v2.X), Y: minNumber(v.Y, v2.Y)}
+}
+
+func (v Vec2[T]) Max(v2 Vec2[T]) Vec2[T] {
+	return Vec2[T]{X: maxNumber(v.X, v2.X), Y: maxNumber(v.Y, v2.Y)}
+}
+
+func (v Vec2[T]) Abs() Vec2[T] {
+	return Vec2[T]{X: absNumber(v.X), Y: absNumber(v.Y)}
+}
+
+func (v Vec2[T]) ToVector2() Vector2 {
+	return Vector2{X: float64(v.X), Y: float64(v.Y)}
+}
+
+// Vec3 is a 3D vector over any numeric type.
+// Methods that can produce fractional results return float64.
+type Vec3[T Number] struct {
+	X T
+	Y T
+	Z T
+}
+
+func (v Vec3[T]) Add(v2 Vec3[T]) Vec3[T] {
+	v.X += v2.X
+	v.Y += v2.Y
+	v.Z += v2.Z
+	return v
+}
+
+func (v Vec3[T]) Sub(v2 Vec3[T]) Vec3[T] {
+	v.X -= v2.X
+	v.Y -= v2.Y
+	v.Z -= v2.Z
+	return v
+}
+
+func (v Vec3[T]) Multiply(scalar T) Vec3[T] {
+	v.X *= scalar
+	v.Y *= scalar
+	v.Z *= scalar
+	return v
+}
+
+func (v Vec3[T]) Divide(scalar T) Vec3[T] {
+	v.X /= scalar
+	v.Y /= scalar
+	v.Z /= scalar
+	return v
+}
+
+func (v Vec3[T]) Dot(v2 Vec3[T]) T {
+	return v.X*v2.X + v.Y*v2.Y + v.Z*v2.Z
+}
+
+func (v Vec3[T]) Cross(v2 Vec3[T]) Vec3[T] {
+	return Vec3[T]{
+		X: v.Y*v2.Z - v.Z*v2.Y,
+		Y: v.Z*v2.X - v.X*v2.Z,
+		Z: v.X*v2.Y - v.Y*v2.X,
+	}
+}
+
+func (v Vec3[T]) Magnitude() float64 {
+	return math.Sqrt(float64(v.Magnitude2()))
+}
+
+func (v Vec3[T]) Magnitude2() T {
+	return v.X*v.X + v.Y*v.Y + v.Z*v.Z
+}
+
+func (v Vec3[T]) Distance(v2 Vec3[T]) float64 {
+	return v.Sub(v2).Magnitude()
+}
+
+func (v Vec3[T]) Distance2(v2 Vec3[T]) T {
+	return v.Sub(v2).Magnitude2()
+}
+
+func (v Vec3[T]) Min(v2 Vec3[T]) Vec3[T] {
+	return Vec3[T]{X: minNumber(v.X, v2.X), Y: minNumber(v.Y, v2.Y), Z: minNumber(v.Z, v2.Z)}
+}
+
+func (v Vec3[T]) Max(v2 Vec3[T]) Vec3[T] {
+	return Vec3[T]{X: maxNumber(v.X, v2.X), Y: maxNumber(v.Y, v2.Y), Z: maxNumber(v.Z, v2.Z)}
+}
+
+func (v Vec3[T]) Abs() Vec3[T] {
+	return Vec3[T]{X: absNumber(v.X), Y: absNumber(v.Y), Z: absNumber(v.Z)}
+}
+
+func (v Vec3[T]) ToVector3() Vector3 {
+	return Vector3{X: float64(v.X), Y: float64(v.Y), Z: float64(v.Z)}
+}
+
+// ConvertVec2 converts between numeric types. Conversions to integers truncate towards zero.
+func ConvertVec2[T, U Number](v Vec2[T]) Vec2[U] {
+	return Vec2[U]{X: U(v.X), Y: U(v.Y)}
+}
+
+// ConvertVec3 converts between numeric types. Conversions to integers truncate towards zero.
+func ConvertVec3[T, U Number](v Vec3[T]) Vec3[U] {
+	return Vec3[U]{X: U(v.X), Y: U(v.Y), Z: U(v.Z)}
+}
+
+// Vec2FromVector2 converts a Vector2. Conversions to integers truncate towards zero.
+func Vec2FromVector2[T Number](v Vector2) Vec2[T] {
+	return Vec2[T]{X: T(v.X), Y: T(v.Y)}
+}
+
+// Vec3FromVector3 converts a Vector3. Conversions to integers truncate towards zero.
+func Vec3FromVector3[T Number](v Vector3) Vec3[T] {
+	return Vec3[T]{X: T(v.X), Y: T(v.Y), Z: T(v.Z)}
+}
+
+func minNumber[T Number](a, b T) T {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxNumber[T Number](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func absNumber[T Number](a T) T {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
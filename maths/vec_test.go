@@ -0,0 +1,50 @@
+package maths_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestVec2(t *testing.T) {
+	a := maths.Vec2[int32]{X: 3, Y: 4}
+	b := maths.Vec2[int32]{X: -1, Y: 2}
+
+	assert.Equal(t, maths.Vec2[int32]{X: 2, Y: 6}, a.Add(b))
+	assert.Equal(t, maths.Vec2[int32]{X: 4, Y: 2}, a.Sub(b))
+	assert.Equal(t, maths.Vec2[int32]{X: 6, Y: 8}, a.Multiply(2))
+	assert.Equal(t, maths.Vec2[int32]{X: 1, Y: 2}, a.Divide(2))
+	assert.Equal(t, int32(5), a.Dot(b))
+	assert.Equal(t, int32(10), a.Cross(b))
+	assert.Equal(t, int32(25), a.Magnitude2())
+	assert.Equal(t, 5.0, a.Magnitude())
+	assert.Equal(t, 5.0, a.Distance(maths.Vec2[int32]{}))
+	assert.Equal(t, int32(20), a.Distance2(b))
+	assert.Equal(t, maths.Vec2[int32]{X: -1, Y: 2}, a.Min(b))
+	assert.Equal(t, maths.Vec2[int32]{X: 3, Y: 4}, a.Max(b))
+	assert.Equal(t, maths.Vec2[int32]{X: 1, Y: 2}, b.Abs())
+
+	f := maths.Vec2[float32]{X: 1.5, Y: -2.5}
+	assert.Equal(t, maths.Vec2[float32]{X: 0.75, Y: -1.25}, f.Divide(2))
+	assert.Equal(t, maths.Vector2{X: 1.5, Y: -2.5}, f.ToVector2())
+}
+
+func TestVec3(t *testing.T) {
+	a := maths.Vector3i{X: 1}
+	b := maths.Vector3i{Y: 1}
+
+	assert.Equal(t, maths.Vector3i{Z: 1}, a.Cross(b))
+	assert.Equal(t, int64(0), a.Dot(b))
+	assert.Equal(t, int64(2), a.Distance2(b))
+	assert.Equal(t, maths.Vector3i{X: 1, Y: 1, Z: 0}, a.Max(b))
+	assert.Equal(t, maths.Vector3{X: 2, Y: 2, Z: 2}, maths.Vector3i{X: 2, Y: 2, Z: 2}.ToVector3())
+	assert.Equal(t, 3.0, maths.Vec3[float32]{X: 1, Y: 2, Z: 2}.Magnitude())
+}
+
+func TestConvertVec(t *testing.T) {
+	assert.Equal(t, maths.Vec2[int32]{X: 1, Y: -2}, maths.ConvertVec2[float64, int32](maths.Vec2[float64]{X: 1.9, Y: -2.9}))
+	assert.Equal(t, maths.Vec3[float32]{X: 1, Y: 2, Z: 3}, maths.ConvertVec3[int64, float32](maths.Vector3i{X: 1, Y: 2, Z: 3}))
+	assert.Equal(t, maths.Vec2[int32]{X: 1, Y: 2}, maths.Vec2FromVector2[int32](maths.Vector2{X: 1.5, Y: 2.5}))
+	assert.Equal(t, maths.Vec3[float32]{X: 0.5}, maths.Vec3FromVector3[float32](maths.Vector3{X: 0.5}))
+	assert.Equal(t, maths.Vector2i{X: 1, Y: -1}, maths.Vector2{X: 1.5, Y: -1.5}.ToVector2Int())
+}
@@ -29,8 +29,16 @@ func (v Vector2) Magnitude() float64 {
 	return math.Sqrt(v.X*v.X + v.Y*v.Y)
 }
 
+func (v Vector2) Magnitude2() float64 {
+	return v.X*v.X + v.Y*v.Y
+}
+
+// Normalize returns a unit vector in the same direction. The zero vector is returned unchanged.
 func (v Vector2) Normalize() Vector2 {
 	div := v.Magnitude()
+	if div == 0 {
+		return v
+	}
 	v.X /= div
 	v.Y /= div
 	return v
@@ -41,87 +49,362 @@ func (v Vector2) Distance(v2 Vector2) float64 {
 }
 
 func (v Vector2) Distance2(v2 Vector2) float64 {
-	v2 = v.Sub(v2)
-	return v.X*v.X + v.Y*v.Y
+	return v.Sub(v2).Magnitude2()
+}
+
+func (v Vector2) Dot(v2 Vector2) float64 {
+	return v.X*v2.X + v.Y*v2.Y
+}
+
+// Cross returns the z component of the 3D cross product.
+// It is positive when v2 is counter-clockwise from v.
+func (v Vector2) Cross(v2 Vector2) float64 {
+	return v.X*v2.Y - v.Y*v2.X
+}
+
+func (v Vector2) Lerp(v2 Vector2, t float64) Vector2 {
+	v.X += (v2.X - v.X) * t
+	v.Y += (v2.Y - v.Y) * t
+	return v
+}
+
+// Angle returns the angle in radians from the positive X axis, in the range [-Pi, Pi].
+func (v Vector2) Angle() float64 {
+	return math.Atan2(v.Y, v.X)
+}
+
+// AngleBetween returns the unsigned angle in radians between v and v2, in the range [0, Pi].
+func (v Vector2) AngleBetween(v2 Vector2) float64 {
+	return math.Atan2(math.Abs(v.Cross(v2)), v.Dot(v2))
+}
+
+// Rotate rotates v counter-clockwise by angle radians.
+func (v Vector2) Rotate(angle float64) Vector2 {
+	sin, cos := math.Sincos(angle)
+	return Vector2{
+		X: v.X*cos - v.Y*sin,
+		Y: v.X*sin + v.Y*cos,
+	}
+}
+
+// Perpendicular returns v rotated 90 degrees counter-clockwise.
+func (v Vector2) Perpendicular() Vector2 {
+	return Vector2{X: -v.Y, Y: v.X}
+}
+
+// Reflect reflects v off a surface with the given unit normal.
+func (v Vector2) Reflect(normal Vector2) Vector2 {
+	return v.Sub(normal.Multiply(2 * v.Dot(normal)))
+}
+
+// Project returns the component of v that is parallel to onto.
+func (v Vector2) Project(onto Vector2) Vector2 {
+	mag2 := onto.Magnitude2()
+	if mag2 == 0 {
+		return Vector2{}
+	}
+	return onto.Multiply(v.Dot(onto) / mag2)
+}
+
+// Reject returns the component of v that is perpendicular to onto.
+func (v Vector2) Reject(onto Vector2) Vector2 {
+	return v.Sub(v.Project(onto))
+}
+
+func (v Vector2) ClampMagnitude(max float64) Vector2 {
+	mag2 := v.Magnitude2()
+	if mag2 <= max*max {
+		return v
+	}
+	return v.Multiply(max / math.Sqrt(mag2))
+}
+
+func (v Vector2) Min(v2 Vector2) Vector2 {
+	return Vector2{X: math.Min(v.X, v2.X), Y: math.Min(v.Y, v2.Y)}
+}
+
+func (v Vector2) Max(v2 Vector2) Vector2 {
+	return Vector2{X: math.Max(v.X, v2.X), Y: math.Max(v.Y, v2.Y)}
+}
+
+func (v Vector2) Abs() Vector2 {
+	return Vector2{X: math.Abs(v.X), Y: math.Abs(v.Y)}
+}
+
+func (v Vector2) Floor() Vector2 {
+	return Vector2{X: math.Floor(v.X), Y: math.Floor(v.Y)}
+}
+
+// ApproxEqual reports whether each component of v is within epsilon of v2.
+func (v Vector2) ApproxEqual(v2 Vector2, epsilon float64) bool {
+	return math.Abs(v.X-v2.X) <= epsilon && math.Abs(v.Y-v2.Y) <= epsilon
 }
 
 func (v Vector2) ToVector2Int() Vector2i {
 	return Vector2i{int64(v.X), int64(v.Y)}
 }
 
-type Vector2i struct {
-	X int64
-	Y int64
+type Vector2i = Vec2[int64]
+
+type Vector3i = Vec3[int64]
+
+type Vector3 struct {
+	X float64
+	Y float64
+	Z float64
 }
 
-func (v Vector2i) Add(v2 Vector2i) Vector2i {
+func (v Vector3) Add(v2 Vector3) Vector3 {
 	v.X += v2.X
 	v.Y += v2.Y
+	v.Z += v2.Z
 	return v
 }
 
-func (v Vector2i) Sub(v2 Vector2i) Vector2i {
+func (v Vector3) Sub(v2 Vector3) Vector3 {
 	v.X -= v2.X
 	v.Y -= v2.Y
+	v.Z -= v2.Z
 	return v
 }
 
-func (v Vector2i) Multiply(scalar int64) Vector2i {
+func (v Vector3) Multiply(scalar float64) Vector3 {
 	v.X *= scalar
 	v.Y *= scalar
+	v.Z *= scalar
 	return v
 }
 
-func (v Vector2i) Divide(scalar int64) Vector2i {
-	v.X /= scalar
-	v.Y /= scalar
+func (v Vector3) ToVector3Int() Vector3i {
+	return Vector3i{int64(v.X), int64(v.Y), int64(v.Z)}
+}
+
+func (v Vector3) Magnitude() float64 {
+	return math.Sqrt(v.X*v.X + v.Y*v.Y + v.Z*v.Z)
+}
+
+func (v Vector3) Magnitude2() float64 {
+	return v.X*v.X + v.Y*v.Y + v.Z*v.Z
+}
+
+// Normalize returns a unit vector in the same direction. The zero vector is returned unchanged.
+func (v Vector3) Normalize() Vector3 {
+	div := v.Magnitude()
+	if div == 0 {
+		return v
+	}
+	v.X /= div
+	v.Y /= div
+	v.Z /= div
 	return v
 }
 
-type Vector3 struct {
+func (v Vector3) Distance(v2 Vector3) float64 {
+	return v.Sub(v2).Magnitude()
+}
+
+func (v Vector3) Distance2(v2 Vector3) float64 {
+	return v.Sub(v2).Magnitude2()
+}
+
+func (v Vector3) Dot(v2 Vector3) float64 {
+	return v.X*v2.X + v.Y*v2.Y + v.Z*v2.Z
+}
+
+func (v Vector3) Cross(v2 Vector3) Vector3 {
+	return Vector3{
+		X: v.Y*v2.Z - v.Z*v2.Y,
+		Y: v.Z*v2.X - v.X*v2.Z,
+		Z: v.X*v2.Y - v.Y*v2.X,
+	}
+}
+
+func (v Vector3) Lerp(v2 Vector3, t float64) Vector3 {
+	v.X += (v2.X - v.X) * t
+	v.Y += (v2.Y - v.Y) * t
+	v.Z += (v2.Z - v.Z) * t
+	return v
+}
+
+// AngleBetween returns the unsigned angle in radians between v and v2, in the range [0, Pi].
+func (v Vector3) AngleBetween(v2 Vector3) float64 {
+	return math.Atan2(v.Cross(v2).Magnitude(), v.Dot(v2))
+}
+
+// Reflect reflects v off a surface with the given unit normal.
+func (v Vector3) Reflect(normal Vector3) Vector3 {
+	return v.Sub(normal.Multiply(2 * v.Dot(normal)))
+}
+
+// Project returns the component of v that is parallel to onto.
+func (v Vector3) Project(onto Vector3) Vector3 {
+	mag2 := onto.Magnitude2()
+	if mag2 == 0 {
+		return Vector3{}
+	}
+	return onto.Multiply(v.Dot(onto) / mag2)
+}
+
+// Reject returns the component of v that is perpendicular to onto.
+func (v Vector3) Reject(onto Vector3) Vector3 {
+	return v.Sub(v.Project(onto))
+}
+
+func (v Vector3) ClampMagnitude(max float64) Vector3 {
+	mag2 := v.Magnitude2()
+	if mag2 <= max*max {
+		return v
+	}
+	return v.Multiply(max / math.Sqrt(mag2))
+}
+
+func (v Vector3) Min(v2 Vector3) Vector3 {
+	return Vector3{X: math.Min(v.X, v2.X), Y: math.Min(v.Y, v2.Y), Z: math.Min(v.Z, v2.Z)}
+}
+
+func (v Vector3) Max(v2 Vector3) Vector3 {
+	return Vector3{X: math.Max(v.X, v2.X), Y: math.Max(v.Y, v2.Y), Z: math.Max(v.Z, v2.Z)}
+}
+
+func (v Vector3) Abs() Vector3 {
+	return Vector3{X: math.Abs(v.X), Y: math.Abs(v.Y), Z: math.Abs(v.Z)}
+}
+
+func (v Vector3) Floor() Vector3 {
+	return Vector3{X: math.Floor(v.X), Y: math.Floor(v.Y), Z: math.Floor(v.Z)}
+}
+
+// ApproxEqual reports whether each component of v is within epsilon of v2.
+func (v Vector3) ApproxEqual(v2 Vector3, epsilon float64) bool {
+	return math.Abs(v.X-v2.X) <= epsilon && math.Abs(v.Y-v2.Y) <= epsilon && math.Abs(v.Z-v2.Z) <= epsilon
+}
+
+type Vector4 struct {
 	X float64
 	Y float64
 	Z float64
+	W float64
 }
 
-func (v Vector3) Add(v2 Vector3) Vector3 {
+func (v Vector4) Add(v2 Vector4) Vector4 {
 	v.X += v2.X
 	v.Y += v2.Y
 	v.Z += v2.Z
+	v.W += v2.W
 	return v
 }
 
-func (v Vector3) Sub(v2 Vector3) Vector3 {
+func (v Vector4) Sub(v2 Vector4) Vector4 {
 	v.X -= v2.X
 	v.Y -= v2.Y
 	v.Z -= v2.Z
+	v.W -= v2.W
 	return v
 }
 
-func (v Vector3) Multiply(scalar float64) Vector3 {
+func (v Vector4) Multiply(scalar float64) Vector4 {
 	v.X *= scalar
 	v.Y *= scalar
 	v.Z *= scalar
+	v.W *= scalar
 	return v
 }
 
-func (v Vector3) Magnitude() float64 {
-	return math.Sqrt(v.X*v.X + v.Y*v.Y + v.Z*v.Z)
+func (v Vector4) Magnitude() float64 {
+	return math.Sqrt(v.Magnitude2())
 }
 
-func (v Vector3) Normalize() Vector3 {
+func (v Vector4) Magnitude2() float64 {
+	return v.X*v.X + v.Y*v.Y + v.Z*v.Z + v.W*v.W
+}
+
+// Normalize returns a unit vector in the same direction. The zero vector is returned unchanged.
+func (v Vector4) Normalize() Vector4 {
 	div := v.Magnitude()
+	if div == 0 {
+		return v
+	}
 	v.X /= div
 	v.Y /= div
 	v.Z /= div
+	v.W /= div
 	return v
 }
 
-func (v Vector3) Distance(v2 Vector3) float64 {
+func (v Vector4) Distance(v2 Vector4) float64 {
 	return v.Sub(v2).Magnitude()
 }
 
-func (v Vector3) Distance2(v2 Vector3) float64 {
-	v2 = v.Sub(v2)
-	return v.X*v.X + v.Y*v.Y + v.Z*v.Z
+func (v Vector4) Distance2(v2 Vector4) float64 {
+	return v.Sub(v2).Magnitude2()
+}
+
+func (v Vector4) Dot(v2 Vector4) float64 {
+	return v.X*v2.X + v.Y*v2.Y + v.Z*v2.Z + v.W*v2.W
+}
+
+func (v Vector4) Lerp(v2 Vector4, t float64) Vector4 {
+	v.X += (v2.X - v.X) * t
+	v.Y += (v2.Y - v.Y) * t
+	v.Z += (v2.Z - v.Z) * t
+	v.W += (v2.W - v.W) * t
+	return v
+}
+
+// AngleBetween returns the unsigned angle in radians between v and v2, in the range [0, Pi].
+func (v Vector4) AngleBetween(v2 Vector4) float64 {
+	div := v.Magnitude() * v2.Magnitude()
+	if div == 0 {
+		return 0
+	}
+	return math.Acos(math.Max(-1, math.Min(1, v.Dot(v2)/div)))
+}
+
+// Reflect reflects v off a surface with the given unit normal.
+func (v Vector4) Reflect(normal Vector4) Vector4 {
+	return v.Sub(normal.Multiply(2 * v.Dot(normal)))
+}
+
+// Project returns the component of v that is parallel to onto.
+func (v Vector4) Project(onto Vector4) Vector4 {
+	mag2 := onto.Magnitude2()
+	if mag2 == 0 {
+		return Vector4{}
+	}
+	return onto.Multiply(v.Dot(onto) / mag2)
+}
+
+// Reject returns the component of v that is perpendicular to onto.
+func (v Vector4) Reject(onto Vector4) Vector4 {
+	return v.Sub(v.Project(onto))
+}
+
+func (v Vector4) ClampMagnitude(max float64) Vector4 {
+	mag2 := v.Magnitude2()
+	if mag2 <= max*max {
+		return v
+	}
+	return v.Multiply(max / math.Sqrt(mag2))
+}
+
+func (v Vector4) Min(v2 Vector4) Vector4 {
+	return Vector4{X: math.Min(v.X, v2.X), Y: math.Min(v.Y, v2.Y), Z: math.Min(v.Z, v2.Z), W: math.Min(v.W, v2.W)}
+}
+
+func (v Vector4) Max(v2 Vector4) Vector4 {
+	return Vector4{X: math.Max(v.X, v2.X), Y: math.Max(v.Y, v2.Y), Z: math.Max(v.Z, v2.Z), W: math.Max(v.W, v2.W)}
+}
+
+func (v Vector4) Abs() Vector4 {
+	return Vector4{X: math.Abs(v.X), Y: math.Abs(v.Y), Z: math.Abs(v.Z), W: math.Abs(v.W)}
+}
+
+func (v Vector4) Floor() Vector4 {
+	return Vector4{X: math.Floor(v.X), Y: math.Floor(v.Y), Z: math.Floor(v.Z), W: math.Floor(v.W)}
+}
+
+// ApproxEqual reports whether each component of v is within epsilon of v2.
+func (v Vector4) ApproxEqual(v2 Vector4, epsilon float64) bool {
+	return math.Abs(v.X-v2.X) <= epsilon && math.Abs(v.Y-v2.Y) <= epsilon &&
+		math.Abs(v.Z-v2.Z) <= epsilon && math.Abs(v.W-v2.W) <= epsilon
 }
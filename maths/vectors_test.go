@@ -1,6 +1,10 @@
 package maths
 
-import "testing"
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
 
 func BenchmarkVector2_Magnitude(b *testing.B) {
 	v := Vector2{
@@ -12,3 +16,86 @@ func BenchmarkVector2_Magnitude(b *testing.B) {
 		v.Magnitude()
 	}
 }
+
+func TestVector2_Distance2(t *testing.T) {
+	assert.Equal(t, 25.0, Vector2{X: 1, Y: 1}.Distance2(Vector2{X: 4, Y: 5}))
+	assert.Equal(t, 50.0, Vector3{X: 1, Y: 1, Z: 1}.Distance2(Vector3{X: 4, Y: 5, Z: 6}))
+}
+
+func TestVector2_Normalize(t *testing.T) {
+	assert.Equal(t, Vector2{X: 0.6, Y: 0.8}, Vector2{X: 3, Y: 4}.Normalize())
+	assert.Equal(t, Vector2{}, Vector2{}.Normalize())
+	assert.Equal(t, Vector3{}, Vector3{}.Normalize())
+	assert.Equal(t, Vector4{}, Vector4{}.Normalize())
+}
+
+func TestVector2_Cross(t *testing.T) {
+	assert.Equal(t, 1.0, Vector2{X: 1}.Cross(Vector2{Y: 1}))
+	assert.Equal(t, -1.0, Vector2{Y: 1}.Cross(Vector2{X: 1}))
+	assert.Equal(t, 0.0, Vector2{X: 2, Y: 2}.Cross(Vector2{X: 1, Y: 1}))
+	assert.Equal(t, Vector3{Z: 1}, Vector3{X: 1}.Cross(Vector3{Y: 1}))
+	assert.Equal(t, Vector3{X: 1}, Vector3{Y: 1}.Cross(Vector3{Z: 1}))
+}
+
+func TestVector2_Angle(t *testing.T) {
+	assert.InDelta(t, math.Pi/2, Vector2{Y: 3}.Angle(), 1e-12)
+	assert.InDelta(t, math.Pi/2, Vector2{X: 1}.AngleBetween(Vector2{Y: -1}), 1e-12)
+	assert.InDelta(t, math.Pi, Vector2{X: 1}.AngleBetween(Vector2{X: -1}), 1e-12)
+	assert.InDelta(t, math.Pi/4, Vector3{X: 1}.AngleBetween(Vector3{X: 1, Z: 1}), 1e-12)
+	assert.InDelta(t, math.Pi/2, Vector4{W: 1}.AngleBetween(Vector4{X: 1}), 1e-12)
+	assert.Equal(t, 0.0, Vector4{}.AngleBetween(Vector4{X: 1}))
+
+	r := Vector2{X: 1}.Rotate(math.Pi / 2)
+	assert.True(t, r.ApproxEqual(Vector2{Y: 1}, 1e-12))
+	assert.Equal(t, Vector2{X: -2, Y: 1}, Vector2{X: 1, Y: 2}.Perpendicular())
+}
+
+func TestVector2_Lerp(t *testing.T) {
+	assert.Equal(t, Vector2{X: 5, Y: -5}, Vector2{}.Lerp(Vector2{X: 10, Y: -10}, 0.5))
+	assert.Equal(t, Vector3{X: 1, Y: 2, Z: 3}, Vector3{}.Lerp(Vector3{X: 1, Y: 2, Z: 3}, 1))
+	assert.Equal(t, Vector4{W: 1}, Vector4{W: 1}.Lerp(Vector4{W: 3}, 0))
+}
+
+func TestVector2_Reflect(t *testing.T) {
+	assert.Equal(t, Vector2{X: 1, Y: 1}, Vector2{X: 1, Y: -1}.Reflect(Vector2{Y: 1}))
+	assert.Equal(t, Vector3{X: -1, Y: 2, Z: 3}, Vector3{X: 1, Y: 2, Z: 3}.Reflect(Vector3{X: 1}))
+}
+
+func TestVector2_Project(t *testing.T) {
+	v := Vector2{X: 3, Y: 4}
+	assert.Equal(t, Vector2{X: 3}, v.Project(Vector2{X: 10}))
+	assert.Equal(t, Vector2{Y: 4}, v.Reject(Vector2{X: 10}))
+	assert.Equal(t, Vector2{}, v.Project(Vector2{}))
+	assert.Equal(t, v, v.Reject(Vector2{}))
+
+	v3 := Vector3{X: 1, Y: 2, Z: 3}
+	assert.Equal(t, Vector3{Z: 3}, v3.Project(Vector3{Z: -1}))
+	assert.Equal(t, Vector3{X: 1, Y: 2}, v3.Reject(Vector3{Z: -1}))
+}
+
+func TestVector2_ClampMagnitude(t *testing.T) {
+	assert.Equal(t, Vector2{X: 3, Y: 4}, Vector2{X: 3, Y: 4}.ClampMagnitude(10))
+	assert.True(t, Vector2{X: 0.6, Y: 0.8}.ApproxEqual(Vector2{X: 3, Y: 4}.ClampMagnitude(1), 1e-12))
+	assert.Equal(t, Vector3{Z: 2}, Vector3{Z: 5}.ClampMagnitude(2))
+	assert.Equal(t, Vector4{}, Vector4{}.ClampMagnitude(0))
+}
+
+func TestVector2_ComponentWise(t *testing.T) {
+	a := Vector2{X: -1.5, Y: 2}
+	b := Vector2{X: 1, Y: -3}
+	assert.Equal(t, Vector2{X: -1.5, Y: -3}, a.Min(b))
+	assert.Equal(t, Vector2{X: 1, Y: 2}, a.Max(b))
+	assert.Equal(t, Vector2{X: 1.5, Y: 2}, a.Abs())
+	assert.Equal(t, Vector2{X: -2, Y: 2}, a.Floor())
+
+	c := Vector4{X: -1.5, Y: 2, Z: 0.5, W: -0.5}
+	assert.Equal(t, Vector4{X: -2, Y: 2, Z: 0, W: -1}, c.Floor())
+	assert.Equal(t, Vector4{X: 1.5, Y: 2, Z: 0.5, W: 0.5}, c.Abs())
+}
+
+func TestVector2_ApproxEqual(t *testing.T) {
+	a, b := 0.1, 0.2
+	assert.True(t, Vector2{X: a + b}.ApproxEqual(Vector2{X: 0.3}, 1e-9))
+	assert.False(t, Vector2{X: a + b}.ApproxEqual(Vector2{X: 0.3}, 0))
+	assert.False(t, Vector3{Z: 1}.ApproxEqual(Vector3{}, 0.5))
+}
@@ -0,0 +1,94 @@
+package physics
+
+import (
+	"github.com/soupstoregames/gamelib/data"
+	"github.com/soupstoregames/gamelib/maths"
+)
+
+const (
+	bodyFlagRemoved = iota
+)
+
+// Body is a rigid body in a World.
+// Bodies with no mass are static and are never moved by the simulation.
+// Sensors detect overlaps but do not collide.
+type Body struct {
+	ID              uint64
+	Shape           Shape
+	Position        maths.Vector2
+	Rotation        float64
+	Velocity        maths.Vector2
+	AngularVelocity float64
+	Restitution     float64
+	Friction        float64
+	Sensor          bool
+
+	force      maths.Vector2
+	torque     float64
+	mass       float64
+	invMass    float64
+	inertia    float64
+	invInertia float64
+	flags      data.Bitfield1[uint8]
+}
+
+// NewBody creates a body with a mass calculated from the area of the shape.
+// A density of zero creates a static body.
+func NewBody(id uint64, shape Shape, position maths.Vector2, density float64) Body {
+	b := Body{
+		ID:       id,
+		Shape:    shape,
+		Position: position,
+		Friction: 0.2,
+	}
+	b.SetMass(shape.Area() * density)
+	return b
+}
+
+// SetMass sets the mass and updates the moment of inertia to match the shape.
+func (b *Body) SetMass(mass float64) {
+	b.mass, b.invMass, b.inertia, b.invInertia = 0, 0, 0, 0
+	if mass <= 0 {
+		return
+	}
+	b.mass = mass
+	b.invMass = 1 / mass
+	b.inertia = b.Shape.Inertia(mass)
+	if b.inertia > 0 {
+		b.invInertia = 1 / b.inertia
+	}
+}
+
+func (b Body) Mass() float64 {
+	return b.mass
+}
+
+func (b Body) Inertia() float64 {
+	return b.inertia
+}
+
+func (b Body) IsStatic() bool {
+	return b.invMass == 0
+}
+
+// ApplyForce applies a force at a world space point for the next step.
+func (b *Body) ApplyForce(force, point maths.Vector2) {
+	b.force = b.force.Add(force)
+	b.torque += point.Sub(b.Position).Cross(force)
+}
+
+// ApplyImpulse immediately changes the velocity as if struck at a world space point.
+func (b *Body) ApplyImpulse(impulse, point maths.Vector2) {
+	b.Velocity = b.Velocity.Add(impulse.Multiply(b.invMass))
+	b.AngularVelocity += b.invInertia * point.Sub(b.Position).Cross(impulse)
+}
+
+// Bounds returns the axis aligned bounds of the body in world space.
+func (b Body) Bounds() maths.Rectangle {
+	return b.Shape.bounds(b.Position, b.Rotation)
+}
+
+// velocityAt returns the velocity of the point offset from the centre of mass by r.
+func (b Body) velocityAt(r maths.Vector2) maths.Vector2 {
+	return b.Velocity.Add(r.Perpendicular().Multiply(b.AngularVelocity))
+}
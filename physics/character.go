@@ -0,0 +1,189 @@
+package physics
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/space"
+	"math"
+)
+
+const (
+	DefaultSkinWidth     = 0.01
+	DefaultMaxSlope      = math.Pi / 4
+	DefaultMaxIterations = 4
+)
+
+// CharacterCollision is a surface the character touched while moving.
+type CharacterCollision struct {
+	Entry space.QuadTreeEntry
+	Hit   maths.Hit
+}
+
+// CharacterController moves a kinematic rectangle through the static geometry in a QuadTree,
+// sliding along whatever it hits.
+type CharacterController struct {
+	Tree *space.QuadTree
+	// Polygon optionally returns the exact convex shape of an entry.
+	// Entries without a polygon collide with their rectangle.
+	Polygon func(e space.QuadTreeEntry) (maths.Polygon, bool)
+	// Filter rejects entries the character should pass through. A nil filter accepts every entry.
+	Filter func(e space.QuadTreeEntry) bool
+
+	// Up is the direction opposite gravity. A zero Up disables grounding, slopes and steps for
+	// top-down movement.
+	Up maths.Vector2
+	// MaxSlope is the steepest angle from Up in radians that the character can stand on and walk up.
+	MaxSlope float64
+	// StepHeight is the tallest ledge the character steps onto instead of being blocked.
+	StepHeight float64
+	// SkinWidth is the gap kept between the character and surfaces so it never starts a move overlapped.
+	SkinWidth     float64
+	MaxIterations int
+
+	// Grounded reports whether the last Move ended standing on a walkable surface.
+	Grounded     bool
+	GroundNormal maths.Vector2
+	// Collisions holds the surfaces touched during the last Move.
+	Collisions []CharacterCollision
+
+	candidates []space.QuadTreeEntry
+}
+
+func NewCharacterController(tree *space.QuadTree) *CharacterController {
+	return &CharacterController{
+		Tree:          tree,
+		Up:            maths.Vector2{Y: 1},
+		MaxSlope:      DefaultMaxSlope,
+		SkinWidth:     DefaultSkinWidth,
+		MaxIterations: DefaultMaxIterations,
+	}
+}
+
+// Move moves rect by displacement, sliding along any surfaces in the way, and returns where it ends up.
+func (c *CharacterController) Move(rect maths.Rectangle, displacement maths.Vector2) maths.Rectangle {
+	c.Collisions = c.Collisions[:0]
+	wasGrounded := c.Grounded
+	c.Grounded = false
+	c.GroundNormal = maths.Vector2{}
+
+	remaining := displacement
+	for i := 0; i < c.MaxIterations && remaining.Magnitude2() > c.SkinWidth*c.SkinWidth*1e-4; i++ {
+		entry, hit, ok := c.sweep(rect, remaining)
+		if !ok {
+			rect = offset(rect, remaining)
+			break
+		}
+		c.Collisions = append(c.Collisions, CharacterCollision{Entry: entry, Hit: hit})
+
+		// stop just short of the surface
+		rect = offset(rect, remaining.Multiply(hit.Time).Add(hit.Normal.Multiply(c.SkinWidth)))
+		remaining = remaining.Multiply(1 - hit.Time)
+
+		if c.walkable(hit.Normal) {
+			c.Grounded = true
+			c.GroundNormal = hit.Normal
+		} else if c.StepHeight > 0 && (wasGrounded || c.Grounded) && math.Abs(hit.Normal.Dot(c.Up)) < maths.Epsilon {
+			if stepped, left, ok := c.step(rect, remaining); ok {
+				rect, remaining = stepped, left
+				c.Grounded = true
+				continue
+			}
+		}
+
+		// slide along the surface with whatever movement is left
+		remaining = c.slide(hit.Normal, remaining)
+	}
+
+	// probe below so standing still on the ground still counts as grounded
+	if !c.Grounded && c.Up != (maths.Vector2{}) {
+		if _, hit, ok := c.sweep(rect, c.Up.Multiply(-2*c.SkinWidth)); ok && c.walkable(hit.Normal) {
+			c.Grounded = true
+			c.GroundNormal = hit.Normal
+		}
+	}
+	return rect
+}
+
+// slide removes the part of remaining that pushes into the surface. Slopes too steep to walk on
+// act as walls when sliding would carry the character up them, but the character still slides down.
+func (c *CharacterController) slide(normal, remaining maths.Vector2) maths.Vector2 {
+	slid := remaining
+	if d := remaining.Dot(normal); d < 0 {
+		slid = remaining.Sub(normal.Multiply(d))
+	}
+	if c.Up == (maths.Vector2{}) || c.walkable(normal) || normal.Dot(c.Up) <= 0 || slid.Dot(c.Up) <= math.Max(0, remaining.Dot(c.Up)) {
+		return slid
+	}
+
+	wall := normal.Reject(c.Up).Normalize()
+	if d := remaining.Dot(wall); d < 0 {
+		return remaining.Sub(wall.Multiply(d))
+	}
+	return remaining
+}
+
+func (c *CharacterController) walkable(normal maths.Vector2) bool {
+	if c.Up == (maths.Vector2{}) {
+		return false
+	}
+	return normal.AngleBetween(c.Up) <= c.MaxSlope+maths.Epsilon
+}
+
+// step tries to climb onto a ledge by moving up, across, then back down onto walkable ground.
+func (c *CharacterController) step(rect maths.Rectangle, remaining maths.Vector2) (maths.Rectangle, maths.Vector2, bool) {
+	up := c.Up.Multiply(c.StepHeight)
+	raised := rect
+	if _, hit, ok := c.sweep(rect, up); ok {
+		raised = offset(rect, up.Multiply(hit.Time).Add(hit.Normal.Multiply(c.SkinWidth)))
+	} else {
+		raised = offset(rect, up)
+	}
+
+	across := remaining.Reject(c.Up)
+	forward := offset(raised, across)
+	left := maths.Vector2{}
+	if _, hit, ok := c.sweep(raised, across); ok {
+		forward = offset(raised, across.Multiply(hit.Time).Add(hit.Normal.Multiply(c.SkinWidth)))
+		left = across.Multiply(1 - hit.Time)
+	}
+	if forward == raised {
+		return rect, remaining, false
+	}
+
+	down := c.Up.Multiply(-c.StepHeight - c.SkinWidth)
+	entry, hit, ok := c.sweep(forward, down)
+	if !ok || !c.walkable(hit.Normal) {
+		return rect, remaining, false
+	}
+	c.Collisions = append(c.Collisions, CharacterCollision{Entry: entry, Hit: hit})
+	c.GroundNormal = hit.Normal
+	return offset(forward, down.Multiply(hit.Time).Add(hit.Normal.Multiply(c.SkinWidth))), left, true
+}
+
+// sweep returns the first entry hit when moving rect by delta.
+func (c *CharacterController) sweep(rect maths.Rectangle, delta maths.Vector2) (space.QuadTreeEntry, maths.Hit, bool) {
+	return c.Tree.SweepFunc(&c.candidates, rect, delta, c.hit)
+}
+
+// hit sweeps rect against a single entry, using its polygon when it has one.
+func (c *CharacterController) hit(rect maths.Rectangle, delta maths.Vector2, e space.QuadTreeEntry) (maths.Hit, bool) {
+	if c.Filter != nil && !c.Filter(e) {
+		return maths.Hit{}, false
+	}
+	if poly, ok := c.polygon(e); ok {
+		return maths.SweepRectanglePolygon(rect, delta, poly)
+	}
+	return maths.SweepRectangle(rect, delta, e.Rect)
+}
+
+func (c *CharacterController) polygon(e space.QuadTreeEntry) (maths.Polygon, bool) {
+	if c.Polygon == nil {
+		return maths.Polygon{}, false
+	}
+	return c.Polygon(e)
+}
+
+func offset(rect maths.Rectangle, delta maths.Vector2) maths.Rectangle {
+	rect.X += delta.X
+	rect.Y += delta.Y
+	return rect
+}
@@ -0,0 +1,148 @@
+package physics_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/physics"
+	"github.com/soupstoregames/gamelib/space"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+const (
+	floorID = iota + 1
+	wallID
+	ledgeID
+	rampID
+)
+
+func newTestLevel(ramp maths.Polygon) *physics.CharacterController {
+	tree := space.NewQuadTree(maths.Rectangle{X: -50, Y: -50, Width: 100, Height: 100})
+	tree.Insert(space.QuadTreeEntry{ID: floorID, Rect: maths.Rectangle{X: -50, Y: -1, Width: 100, Height: 1}})
+	tree.Insert(space.QuadTreeEntry{ID: wallID, Rect: maths.Rectangle{X: -10, Y: 0, Width: 1, Height: 20}})
+	tree.Insert(space.QuadTreeEntry{ID: ledgeID, Rect: maths.Rectangle{X: 5, Y: 0, Width: 5, Height: 0.3}})
+	if ramp.Vertices != nil {
+		tree.Insert(space.QuadTreeEntry{ID: rampID, Rect: ramp.Bounds()})
+	}
+
+	c := physics.NewCharacterController(tree)
+	c.Polygon = func(e space.QuadTreeEntry) (maths.Polygon, bool) {
+		return ramp, e.ID == rampID
+	}
+	return c
+}
+
+func collided(c *physics.CharacterController, id uint64) bool {
+	for _, collision := range c.Collisions {
+		if collision.Entry.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCharacterController_Land(t *testing.T) {
+	c := newTestLevel(maths.Polygon{})
+	rect := c.Move(maths.Rectangle{X: 0, Y: 3, Width: 1, Height: 2}, maths.Vector2{Y: -5})
+
+	assert.InDelta(t, 0.0, rect.Y, 2*physics.DefaultSkinWidth)
+	assert.True(t, c.Grounded)
+	assert.Equal(t, maths.Vector2{Y: 1}, c.GroundNormal)
+	assert.True(t, collided(c, floorID))
+
+	// standing still stays grounded
+	c.Move(rect, maths.Vector2{})
+	assert.True(t, c.Grounded)
+}
+
+func TestCharacterController_SlideAlongWall(t *testing.T) {
+	c := newTestLevel(maths.Polygon{})
+	rect := c.Move(maths.Rectangle{X: -8, Y: 5, Width: 1, Height: 2}, maths.Vector2{X: -4, Y: 2})
+
+	assert.InDelta(t, -9, rect.X, 2*physics.DefaultSkinWidth)
+	assert.InDelta(t, 7, rect.Y, 1e-9, "keeps moving up along the wall")
+	assert.False(t, c.Grounded)
+	assert.True(t, collided(c, wallID))
+}
+
+func TestCharacterController_StepUp(t *testing.T) {
+	cases := map[string]struct {
+		stepHeight float64
+		climbs     bool
+	}{
+		"steps onto low ledge":  {stepHeight: 0.5, climbs: true},
+		"blocked by tall ledge": {stepHeight: 0.2, climbs: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newTestLevel(maths.Polygon{})
+			c.StepHeight = tc.stepHeight
+			rect := c.Move(maths.Rectangle{X: 2, Y: 0.5, Width: 1, Height: 2}, maths.Vector2{Y: -1})
+			assert.True(t, c.Grounded)
+
+			rect = c.Move(rect, maths.Vector2{X: 3})
+			assert.True(t, c.Grounded)
+			if tc.climbs {
+				assert.InDelta(t, 0.3, rect.Y, 2*physics.DefaultSkinWidth)
+				assert.InDelta(t, 5, rect.X, 2*physics.DefaultSkinWidth)
+			} else {
+				assert.InDelta(t, 0, rect.Y, 2*physics.DefaultSkinWidth)
+				assert.InDelta(t, 4, rect.X, 2*physics.DefaultSkinWidth)
+			}
+		})
+	}
+}
+
+func TestCharacterController_Slopes(t *testing.T) {
+	cases := map[string]struct {
+		angle  float64
+		climbs bool
+	}{
+		"walkable":  {angle: math.Pi / 6, climbs: true},
+		"too steep": {angle: math.Pi / 3, climbs: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			height := 10 * math.Tan(tc.angle)
+			ramp := maths.Polygon{Vertices: []maths.Vector2{{X: -5, Y: 0}, {X: 5, Y: 0}, {X: 5, Y: height}}}
+			c := newTestLevel(ramp)
+
+			rect := maths.Rectangle{X: -7, Y: 0.005, Width: 1, Height: 2}
+			for i := 0; i < 10; i++ {
+				rect = c.Move(rect, maths.Vector2{X: 0.5, Y: -0.1})
+			}
+
+			assert.True(t, collided(c, rampID))
+			if tc.climbs {
+				assert.Greater(t, rect.Y, 1.0)
+				assert.True(t, c.Grounded)
+			} else {
+				assert.Less(t, rect.Y, 0.1)
+			}
+		})
+	}
+}
+
+func TestCharacterController_TopDown(t *testing.T) {
+	c := newTestLevel(maths.Polygon{})
+	c.Up = maths.Vector2{}
+	rect := c.Move(maths.Rectangle{X: 0, Y: 3, Width: 1, Height: 2}, maths.Vector2{X: 1, Y: -5})
+
+	assert.False(t, c.Grounded)
+	assert.InDelta(t, 1, rect.X, 1e-9)
+	assert.InDelta(t, 0, rect.Y, 2*physics.DefaultSkinWidth)
+}
+
+func TestCharacterController_NoAllocations(t *testing.T) {
+	c := newTestLevel(maths.Polygon{})
+	rect := maths.Rectangle{X: -8, Y: 0.01, Width: 1, Height: 2}
+
+	// the first move grows the scratch space
+	c.Move(rect, maths.Vector2{X: -4, Y: -1})
+	allocs := testing.AllocsPerRun(20, func() {
+		c.Move(rect, maths.Vector2{X: -4, Y: -1})
+	})
+	assert.Zero(t, allocs)
+}
@@ -0,0 +1,115 @@
+package physics
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"math"
+)
+
+const (
+	// penetration allowed before position correction kicks in, avoids jitter in resting contacts
+	penetrationSlop = 0.01
+	// fraction of the remaining penetration corrected each step
+	baumgarte = 0.2
+	// closing speeds below this do not bounce, so resting bodies settle
+	restitutionThreshold = 1
+)
+
+// Contact is a pair of touching bodies found during the last step.
+// The manifold normal points from A towards B.
+type Contact struct {
+	A, B     int
+	Manifold maths.Manifold
+
+	friction    float64
+	restitution float64
+	points      [2]contactPoint
+}
+
+type contactPoint struct {
+	rA, rB         maths.Vector2
+	normalMass     float64
+	tangentMass    float64
+	bias           float64
+	normalImpulse  float64
+	tangentImpulse float64
+}
+
+// collide runs the narrow phase between two bodies.
+func collide(a, b Body) (maths.Manifold, bool) {
+	switch {
+	case a.Shape.Kind == ShapeCircle && b.Shape.Kind == ShapeCircle:
+		return maths.CircleCircleContact(maths.NewCircle(a.Position, a.Shape.Radius), maths.NewCircle(b.Position, b.Shape.Radius))
+	case a.Shape.Kind == ShapeCircle:
+		return maths.CirclePolygonContact(maths.NewCircle(a.Position, a.Shape.Radius), b.Shape.polygon(b.Position, b.Rotation))
+	case b.Shape.Kind == ShapeCircle:
+		m, ok := maths.CirclePolygonContact(maths.NewCircle(b.Position, b.Shape.Radius), a.Shape.polygon(a.Position, a.Rotation))
+		return m.Flip(), ok
+	default:
+		return maths.PolygonPolygonContact(a.Shape.polygon(a.Position, a.Rotation), b.Shape.polygon(b.Position, b.Rotation))
+	}
+}
+
+// prepare caches the effective masses and velocity targets for each contact point.
+func (c *Contact) prepare(a, b Body, dt float64) {
+	c.friction = math.Sqrt(a.Friction * b.Friction)
+	c.restitution = math.Max(a.Restitution, b.Restitution)
+
+	n := c.Manifold.Normal
+	t := n.Perpendicular()
+	for i := 0; i < c.Manifold.Count; i++ {
+		cp := c.Manifold.Contacts[i]
+		p := &c.points[i]
+		p.rA = cp.Point.Sub(a.Position)
+		p.rB = cp.Point.Sub(b.Position)
+
+		rnA, rnB := p.rA.Cross(n), p.rB.Cross(n)
+		k := a.invMass + b.invMass + a.invInertia*rnA*rnA + b.invInertia*rnB*rnB
+		if k > 0 {
+			p.normalMass = 1 / k
+		}
+		rtA, rtB := p.rA.Cross(t), p.rB.Cross(t)
+		k = a.invMass + b.invMass + a.invInertia*rtA*rtA + b.invInertia*rtB*rtB
+		if k > 0 {
+			p.tangentMass = 1 / k
+		}
+
+		p.bias = baumgarte / dt * math.Max(0, cp.Depth-penetrationSlop)
+		vn := b.velocityAt(p.rB).Sub(a.velocityAt(p.rA)).Dot(n)
+		if vn < -restitutionThreshold {
+			p.bias = math.Max(p.bias, -c.restitution*vn)
+		}
+	}
+}
+
+// solve applies one iteration of sequential impulses to the pair.
+func (c *Contact) solve(a, b *Body) {
+	n := c.Manifold.Normal
+	t := n.Perpendicular()
+	for i := 0; i < c.Manifold.Count; i++ {
+		p := &c.points[i]
+
+		// normal impulses push the bodies apart and never pull them together
+		vn := b.velocityAt(p.rB).Sub(a.velocityAt(p.rA)).Dot(n)
+		impulse := p.normalMass * (p.bias - vn)
+		total := math.Max(p.normalImpulse+impulse, 0)
+		impulse = total - p.normalImpulse
+		p.normalImpulse = total
+		applyImpulse(a, b, n.Multiply(impulse), p.rA, p.rB)
+
+		// friction is limited by how hard the bodies are pressed together
+		vt := b.velocityAt(p.rB).Sub(a.velocityAt(p.rA)).Dot(t)
+		impulse = -p.tangentMass * vt
+		limit := c.friction * p.normalImpulse
+		total = math.Max(-limit, math.Min(p.tangentImpulse+impulse, limit))
+		impulse = total - p.tangentImpulse
+		p.tangentImpulse = total
+		applyImpulse(a, b, t.Multiply(impulse), p.rA, p.rB)
+	}
+}
+
+func applyImpulse(a, b *Body, impulse, rA, rB maths.Vector2) {
+	a.Velocity = a.Velocity.Sub(impulse.Multiply(a.invMass))
+	a.AngularVelocity -= a.invInertia * rA.Cross(impulse)
+	b.Velocity = b.Velocity.Add(impulse.Multiply(b.invMass))
+	b.AngularVelocity += b.invInertia * rB.Cross(impulse)
+}
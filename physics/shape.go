@@ -0,0 +1,106 @@
+package physics
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"math"
+)
+
+const (
+	ShapeCircle = iota
+	ShapeBox
+	ShapePolygon
+)
+
+// Shape is the collision shape of a body in the body's local space.
+// The centre of mass is always at the local origin.
+type Shape struct {
+	Kind        int
+	Radius      float64
+	HalfExtents maths.Vector2
+	Vertices    []maths.Vector2
+}
+
+func NewCircleShape(radius float64) Shape {
+	return Shape{Kind: ShapeCircle, Radius: radius}
+}
+
+func NewBoxShape(width, height float64) Shape {
+	return Shape{Kind: ShapeBox, HalfExtents: maths.Vector2{X: width / 2, Y: height / 2}}
+}
+
+// NewPolygonShape creates a shape from the vertices of a convex polygon.
+// The vertices are moved so that the centroid of the polygon is at the local origin.
+func NewPolygonShape(vertices []maths.Vector2) Shape {
+	poly := maths.Polygon{Vertices: vertices}
+	centroid := poly.Centroid()
+	local := make([]maths.Vector2, len(vertices))
+	for i, v := range vertices {
+		local[i] = v.Sub(centroid)
+	}
+	// keep counter-clockwise winding so the inertia calculation is positive
+	if poly.SignedArea() < 0 {
+		for i, j := 0, len(local)-1; i < j; i, j = i+1, j-1 {
+			local[i], local[j] = local[j], local[i]
+		}
+	}
+	return Shape{Kind: ShapePolygon, Vertices: local}
+}
+
+func (s Shape) Area() float64 {
+	switch s.Kind {
+	case ShapeCircle:
+		return math.Pi * s.Radius * s.Radius
+	case ShapeBox:
+		return 4 * s.HalfExtents.X * s.HalfExtents.Y
+	default:
+		return maths.Polygon{Vertices: s.Vertices}.Area()
+	}
+}
+
+// Inertia returns the moment of inertia around the local origin for the given mass.
+func (s Shape) Inertia(mass float64) float64 {
+	switch s.Kind {
+	case ShapeCircle:
+		return mass * s.Radius * s.Radius / 2
+	case ShapeBox:
+		w, h := 2*s.HalfExtents.X, 2*s.HalfExtents.Y
+		return mass * (w*w + h*h) / 12
+	default:
+		// sum the triangles fanning out from the origin
+		var numerator, denominator float64
+		for i, a := range s.Vertices {
+			b := s.Vertices[(i+1)%len(s.Vertices)]
+			cross := math.Abs(a.Cross(b))
+			numerator += cross * (a.Dot(a) + a.Dot(b) + b.Dot(b))
+			denominator += cross
+		}
+		if denominator == 0 {
+			return 0
+		}
+		return mass * numerator / (6 * denominator)
+	}
+}
+
+// polygon returns the world space vertices of a box or polygon shape.
+func (s Shape) polygon(position maths.Vector2, rotation float64) maths.Polygon {
+	var local []maths.Vector2
+	if s.Kind == ShapeBox {
+		h := s.HalfExtents
+		local = []maths.Vector2{{X: -h.X, Y: -h.Y}, {X: h.X, Y: -h.Y}, {X: h.X, Y: h.Y}, {X: -h.X, Y: h.Y}}
+	} else {
+		local = s.Vertices
+	}
+
+	vertices := make([]maths.Vector2, len(local))
+	for i, v := range local {
+		vertices[i] = v.Rotate(rotation).Add(position)
+	}
+	return maths.Polygon{Vertices: vertices}
+}
+
+func (s Shape) bounds(position maths.Vector2, rotation float64) maths.Rectangle {
+	if s.Kind == ShapeCircle {
+		return maths.NewCircle(position, s.Radius).Bounds()
+	}
+	return s.polygon(position, rotation).Bounds()
+}
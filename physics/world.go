@@ -0,0 +1,270 @@
+package physics
+
+import (
+	"github.com/soupstoregames/gamelib/data"
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/space"
+	"sort"
+)
+
+const (
+	DefaultTimeStep   = 1.0 / 60
+	DefaultIterations = 8
+	DefaultMaxSteps   = 8
+)
+
+type bodyPair struct {
+	a, b int
+}
+
+// World simulates rigid bodies with a fixed timestep.
+// Bodies are processed in index order and no maps are iterated, so the same inputs in the same
+// order always produce the same results.
+type World struct {
+	Gravity    maths.Vector2
+	TimeStep   float64
+	Iterations int
+	// MaxSteps limits how many steps a single Update can take so a slow frame cannot snowball.
+	MaxSteps int
+
+	// OnSensorEnter and OnSensorExit are called when a body starts or stops overlapping a sensor.
+	OnSensorEnter func(sensorID, bodyID int)
+	OnSensorExit  func(sensorID, bodyID int)
+
+	bodies      data.FreeList[Body]
+	tree        *space.QuadTree
+	accumulator float64
+
+	entries  []space.QuadTreeEntry
+	pairs    []bodyPair
+	contacts []Contact
+	overlaps []bodyPair
+	previous []bodyPair
+}
+
+// NewWorld creates a world for bodies within bounds.
+// Bodies that leave the bounds are still simulated but no longer collide.
+func NewWorld(bounds maths.Rectangle, gravity maths.Vector2) *World {
+	return &World{
+		Gravity:    gravity,
+		TimeStep:   DefaultTimeStep,
+		Iterations: DefaultIterations,
+		MaxSteps:   DefaultMaxSteps,
+		bodies:     data.NewFreeList[Body](),
+		tree:       space.NewQuadTree(bounds),
+	}
+}
+
+func (w *World) Insert(body Body) int {
+	body.flags.Clear(bodyFlagRemoved)
+	return w.bodies.Insert(body)
+}
+
+func (w *World) Remove(bodyID int) {
+	body := w.bodies.Get(bodyID)
+	body.flags.Set(bodyFlagRemoved)
+	w.bodies.Set(bodyID, body)
+	w.bodies.Erase(bodyID)
+}
+
+func (w *World) Get(bodyID int) Body {
+	return w.bodies.Get(bodyID)
+}
+
+func (w *World) Set(bodyID int, body Body) {
+	w.bodies.Set(bodyID, body)
+}
+
+// ApplyForce applies a force at a world space point for the next step.
+func (w *World) ApplyForce(bodyID int, force, point maths.Vector2) {
+	body := w.bodies.Get(bodyID)
+	body.ApplyForce(force, point)
+	w.bodies.Set(bodyID, body)
+}
+
+// ApplyImpulse immediately changes the velocity of the body as if struck at a world space point.
+func (w *World) ApplyImpulse(bodyID int, impulse, point maths.Vector2) {
+	body := w.bodies.Get(bodyID)
+	body.ApplyImpulse(impulse, point)
+	w.bodies.Set(bodyID, body)
+}
+
+// Contacts appends the contacts found during the last step.
+func (w *World) Contacts(contacts *[]Contact) {
+	*contacts = append(*contacts, w.contacts...)
+}
+
+// Update advances the simulation by dt in fixed steps and returns the number of steps taken.
+// Time that does not fill a whole step is carried over to the next update.
+func (w *World) Update(dt float64) int {
+	w.accumulator += dt
+	steps := 0
+	for w.accumulator >= w.TimeStep {
+		if steps == w.MaxSteps {
+			w.accumulator = 0
+			break
+		}
+		w.Step()
+		w.accumulator -= w.TimeStep
+		steps++
+	}
+	return steps
+}
+
+// Alpha returns how far the leftover time is into the next step, for interpolating rendering.
+func (w *World) Alpha() float64 {
+	return w.accumulator / w.TimeStep
+}
+
+// Step advances the simulation by a single timestep.
+func (w *World) Step() {
+	dt := w.TimeStep
+
+	// apply forces
+	for i := 0; i < w.bodies.Len(); i++ {
+		body := w.bodies.Get(i)
+		if body.flags.Has(bodyFlagRemoved) {
+			continue
+		}
+		if !body.IsStatic() {
+			body.Velocity = body.Velocity.Add(w.Gravity.Add(body.force.Multiply(body.invMass)).Multiply(dt))
+			body.AngularVelocity += body.torque * body.invInertia * dt
+		}
+		body.force = maths.Vector2{}
+		body.torque = 0
+		w.bodies.Set(i, body)
+	}
+
+	w.broadPhase()
+	w.narrowPhase()
+
+	for i := range w.contacts {
+		c := &w.contacts[i]
+		c.prepare(w.bodies.Get(c.A), w.bodies.Get(c.B), dt)
+	}
+	for iteration := 0; iteration < w.Iterations; iteration++ {
+		for i := range w.contacts {
+			c := &w.contacts[i]
+			a, b := w.bodies.Get(c.A), w.bodies.Get(c.B)
+			c.solve(&a, &b)
+			w.bodies.Set(c.A, a)
+			w.bodies.Set(c.B, b)
+		}
+	}
+
+	// integrate velocities
+	for i := 0; i < w.bodies.Len(); i++ {
+		body := w.bodies.Get(i)
+		if body.flags.Has(bodyFlagRemoved) || body.IsStatic() {
+			continue
+		}
+		body.Position = body.Position.Add(body.Velocity.Multiply(dt))
+		body.Rotation += body.AngularVelocity * dt
+		w.bodies.Set(i, body)
+	}
+
+	w.notifySensors()
+}
+
+// broadPhase finds the pairs of bodies whose bounds overlap, sorted so the solver order is stable.
+func (w *World) broadPhase() {
+	w.tree.Clear()
+	for i := 0; i < w.bodies.Len(); i++ {
+		body := w.bodies.Get(i)
+		if body.flags.Has(bodyFlagRemoved) {
+			continue
+		}
+		w.tree.Insert(space.QuadTreeEntry{ID: uint64(i), Rect: body.Bounds()})
+	}
+
+	w.pairs = w.pairs[:0]
+	for i := 0; i < w.bodies.Len(); i++ {
+		body := w.bodies.Get(i)
+		if body.flags.Has(bodyFlagRemoved) {
+			continue
+		}
+		w.entries = w.entries[:0]
+		w.tree.Scan(&w.entries, body.Bounds())
+		for _, e := range w.entries {
+			j := int(e.ID)
+			// each pair is found from both sides, keep the one from the lower index
+			if j <= i {
+				continue
+			}
+			other := w.bodies.Get(j)
+			if body.IsStatic() && other.IsStatic() && !body.Sensor && !other.Sensor {
+				continue
+			}
+			w.pairs = append(w.pairs, bodyPair{a: i, b: j})
+		}
+	}
+
+	// entries that span several quadrants are found more than once
+	sort.Slice(w.pairs, func(i, j int) bool {
+		return pairLess(w.pairs[i], w.pairs[j])
+	})
+	unique := 0
+	for i, p := range w.pairs {
+		if i > 0 && p == w.pairs[unique-1] {
+			continue
+		}
+		w.pairs[unique] = p
+		unique++
+	}
+	w.pairs = w.pairs[:unique]
+}
+
+func (w *World) narrowPhase() {
+	w.contacts = w.contacts[:0]
+	w.previous, w.overlaps = w.overlaps, w.previous[:0]
+	for _, p := range w.pairs {
+		a, b := w.bodies.Get(p.a), w.bodies.Get(p.b)
+		m, ok := collide(a, b)
+		if !ok {
+			continue
+		}
+		if a.Sensor || b.Sensor {
+			w.overlaps = append(w.overlaps, p)
+			continue
+		}
+		w.contacts = append(w.contacts, Contact{A: p.a, B: p.b, Manifold: m})
+	}
+}
+
+// notifySensors compares this step's sensor overlaps with the last step's.
+// Both lists are sorted, so they can be walked together.
+func (w *World) notifySensors() {
+	i, j := 0, 0
+	for i < len(w.previous) || j < len(w.overlaps) {
+		switch {
+		case j == len(w.overlaps) || (i < len(w.previous) && pairLess(w.previous[i], w.overlaps[j])):
+			w.sensorEvent(w.OnSensorExit, w.previous[i])
+			i++
+		case i == len(w.previous) || pairLess(w.overlaps[j], w.previous[i]):
+			w.sensorEvent(w.OnSensorEnter, w.overlaps[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+}
+
+func (w *World) sensorEvent(f func(sensorID, bodyID int), p bodyPair) {
+	if f == nil {
+		return
+	}
+	if w.bodies.Get(p.a).Sensor {
+		f(p.a, p.b)
+	}
+	if w.bodies.Get(p.b).Sensor {
+		f(p.b, p.a)
+	}
+}
+
+func pairLess(p1, p2 bodyPair) bool {
+	if p1.a != p2.a {
+		return p1.a < p2.a
+	}
+	return p1.b < p2.b
+}
@@ -0,0 +1,145 @@
+package physics_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/physics"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func newTestWorld() *physics.World {
+	w := physics.NewWorld(maths.Rectangle{X: -100, Y: -100, Width: 200, Height: 200}, maths.Vector2{Y: -10})
+	w.Insert(physics.NewBody(0, physics.NewBoxShape(100, 2), maths.Vector2{Y: -1}, 0))
+	return w
+}
+
+func TestShape_Inertia(t *testing.T) {
+	box := physics.NewBoxShape(2, 4)
+	poly := physics.NewPolygonShape([]maths.Vector2{{X: 5, Y: 5}, {X: 7, Y: 5}, {X: 7, Y: 9}, {X: 5, Y: 9}})
+	assert.InDelta(t, box.Inertia(3), poly.Inertia(3), 1e-9)
+	assert.InDelta(t, 8.0, poly.Area(), 1e-9)
+	assert.InDelta(t, 1.5, physics.NewCircleShape(1).Inertia(3), 1e-9)
+}
+
+func TestWorld_RestsOnGround(t *testing.T) {
+	cases := map[string]physics.Shape{
+		"circle":  physics.NewCircleShape(1),
+		"box":     physics.NewBoxShape(2, 2),
+		"polygon": physics.NewPolygonShape([]maths.Vector2{{X: -1, Y: -1}, {X: 1, Y: -1}, {X: 0, Y: 1}}),
+	}
+
+	for name, shape := range cases {
+		t.Run(name, func(t *testing.T) {
+			w := newTestWorld()
+			id := w.Insert(physics.NewBody(1, shape, maths.Vector2{Y: 5}, 1))
+			for i := 0; i < 300; i++ {
+				w.Step()
+			}
+
+			body := w.Get(id)
+			assert.InDelta(t, 0.0, body.Velocity.Magnitude(), 0.05)
+			assert.InDelta(t, 0.0, body.Bounds().Y, 0.05, "resting on the ground")
+		})
+	}
+}
+
+func TestWorld_Restitution(t *testing.T) {
+	w := newTestWorld()
+	ball := physics.NewBody(1, physics.NewCircleShape(1), maths.Vector2{Y: 10}, 1)
+	ball.Restitution = 1
+	id := w.Insert(ball)
+
+	peak := 0.0
+	bounced := false
+	for i := 0; i < 240; i++ {
+		w.Step()
+		body := w.Get(id)
+		if body.Velocity.Y > 0 {
+			bounced = true
+		}
+		if bounced {
+			peak = math.Max(peak, body.Position.Y)
+		}
+	}
+	assert.True(t, bounced)
+	assert.Greater(t, peak, 8.0)
+}
+
+func TestWorld_Friction(t *testing.T) {
+	slide := func(friction float64) float64 {
+		w := newTestWorld()
+		box := physics.NewBody(1, physics.NewBoxShape(2, 2), maths.Vector2{Y: 1}, 1)
+		box.Friction = friction
+		box.Velocity = maths.Vector2{X: 5}
+		id := w.Insert(box)
+		for i := 0; i < 120; i++ {
+			w.Step()
+		}
+		return w.Get(id).Position.X
+	}
+
+	assert.Greater(t, slide(0), slide(1)*2)
+}
+
+func TestWorld_Sensors(t *testing.T) {
+	w := physics.NewWorld(maths.Rectangle{X: -100, Y: -100, Width: 200, Height: 200}, maths.Vector2{})
+	sensor := physics.NewBody(0, physics.NewBoxShape(2, 2), maths.Vector2{}, 0)
+	sensor.Sensor = true
+	sensorID := w.Insert(sensor)
+
+	ball := physics.NewBody(1, physics.NewCircleShape(0.5), maths.Vector2{X: -3}, 1)
+	ball.Velocity = maths.Vector2{X: 60}
+	ballID := w.Insert(ball)
+
+	var events []string
+	w.OnSensorEnter = func(s, b int) {
+		assert.Equal(t, sensorID, s)
+		assert.Equal(t, ballID, b)
+		events = append(events, "enter")
+	}
+	w.OnSensorExit = func(s, b int) {
+		events = append(events, "exit")
+	}
+
+	for i := 0; i < 12; i++ {
+		w.Step()
+	}
+	assert.Equal(t, []string{"enter", "exit"}, events)
+	assert.Equal(t, 60.0, w.Get(ballID).Velocity.X, "sensors do not collide")
+}
+
+func TestWorld_Update(t *testing.T) {
+	w := newTestWorld()
+	assert.Equal(t, 0, w.Update(physics.DefaultTimeStep/2))
+	assert.Equal(t, 1, w.Update(physics.DefaultTimeStep*0.75))
+	assert.InDelta(t, 0.25, w.Alpha(), 1e-9)
+	assert.Equal(t, physics.DefaultMaxSteps, w.Update(1))
+}
+
+func TestWorld_Deterministic(t *testing.T) {
+	run := func() []physics.Body {
+		w := newTestWorld()
+		var ids []int
+		for i := 0; i < 20; i++ {
+			shape := physics.NewCircleShape(0.5)
+			if i%2 == 0 {
+				shape = physics.NewBoxShape(1, 1)
+			}
+			body := physics.NewBody(uint64(i+1), shape, maths.Vector2{X: float64(i%5) * 1.1, Y: 2 + float64(i)}, 1)
+			body.Rotation = float64(i) * 0.1
+			ids = append(ids, w.Insert(body))
+		}
+		for i := 0; i < 200; i++ {
+			w.Step()
+		}
+
+		var bodies []physics.Body
+		for _, id := range ids {
+			bodies = append(bodies, w.Get(id))
+		}
+		return bodies
+	}
+
+	assert.Equal(t, run(), run())
+}
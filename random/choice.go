@@ -0,0 +1,60 @@
+package random
+
+// Shuffle randomly reorders the slice in place.
+func Shuffle[T any](r *Rand, s []T) {
+	for i := len(s) - 1; i > 0; i-- {
+		j := r.Intn(i + 1)
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Choice returns a uniformly chosen element, or false if the slice is empty.
+func Choice[T any](r *Rand, s []T) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s[r.Intn(len(s))], true
+}
+
+// WeightedIndex returns an index chosen with probability proportional to its weight.
+// Negative weights count as zero. It returns false if no weight is positive.
+func (r *Rand) WeightedIndex(weights []float64) (int, bool) {
+	var total float64
+	for _, w := range weights {
+		if w > 0 {
+			total += w
+		}
+	}
+	if total <= 0 {
+		return -1, false
+	}
+
+	target := r.Float64() * total
+	last := -1
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		if target < w {
+			return i, true
+		}
+		target -= w
+		last = i
+	}
+	// rounding can leave a little of the target over
+	return last, true
+}
+
+// WeightedChoice returns an element chosen with probability proportional to its weight.
+func WeightedChoice[T any](r *Rand, items []T, weights []float64) (T, bool) {
+	if len(weights) > len(items) {
+		weights = weights[:len(items)]
+	}
+	i, ok := r.WeightedIndex(weights)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return items[i], true
+}
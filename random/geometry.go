@@ -0,0 +1,45 @@
+package random
+
+import (
+	"math"
+
+	"github.com/soupstoregames/gamelib/maths"
+)
+
+// Angle returns a uniform angle in radians in [0, 2π).
+func (r *Rand) Angle() float64 {
+	return r.Float64() * 2 * math.Pi
+}
+
+// UnitVector2 returns a uniformly distributed direction.
+func (r *Rand) UnitVector2() maths.Vector2 {
+	angle := r.Angle()
+	return maths.Vector2{X: math.Cos(angle), Y: math.Sin(angle)}
+}
+
+// UnitVector3 returns a uniformly distributed direction.
+func (r *Rand) UnitVector3() maths.Vector3 {
+	// a uniform height and angle on the cylinder project to a uniform point on the sphere
+	z := r.Float64()*2 - 1
+	angle := r.Angle()
+	radius := math.Sqrt(1 - z*z)
+	return maths.Vector3{X: radius * math.Cos(angle), Y: radius * math.Sin(angle), Z: z}
+}
+
+// PointInRectangle returns a uniformly distributed point inside the rectangle.
+func (r *Rand) PointInRectangle(rect maths.Rectangle) maths.Vector2 {
+	return maths.Vector2{
+		X: rect.X + r.Float64()*rect.Width,
+		Y: rect.Y + r.Float64()*rect.Height,
+	}
+}
+
+// PointInCircle returns a uniformly distributed point inside the circle.
+func (r *Rand) PointInCircle(c maths.Circle) maths.Vector2 {
+	return c.Center.Add(r.UnitVector2().Multiply(c.Radius * math.Sqrt(r.Float64())))
+}
+
+// PointInSphere returns a uniformly distributed point inside the sphere.
+func (r *Rand) PointInSphere(s maths.Sphere) maths.Vector3 {
+	return s.Center.Add(r.UnitVector3().Multiply(s.Radius * math.Cbrt(r.Float64())))
+}
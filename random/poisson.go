@@ -0,0 +1,209 @@
+package random
+
+import (
+	"math"
+
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/space"
+)
+
+// PoissonAttempts is how many candidates are tried around each point before it is retired.
+const PoissonAttempts = 30
+
+// PoissonDisk appends evenly spaced random points inside bounds, no two closer than radius,
+// using Bridson's algorithm. Use a PoissonSampler for other regions or varying spacing.
+func (r *Rand) PoissonDisk(results *[]maths.Vector2, bounds maths.Rectangle, radius float64) {
+	if radius <= 0 || bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+
+	// a cell is small enough to hold at most one point
+	cell := radius / math.Sqrt2
+	cols, rows := int(math.Ceil(bounds.Width/cell)), int(math.Ceil(bounds.Height/cell))
+	grid := make([]int, cols*rows)
+	for i := range grid {
+		grid[i] = -1
+	}
+	cellOf := func(p maths.Vector2) (int, int) {
+		x, y := int((p.X-bounds.X)/cell), int((p.Y-bounds.Y)/cell)
+		return clampInt(x, 0, cols-1), clampInt(y, 0, rows-1)
+	}
+
+	start := len(*results)
+	add := func(p maths.Vector2) {
+		x, y := cellOf(p)
+		grid[y*cols+x] = len(*results) - start
+		*results = append(*results, p)
+	}
+	fits := func(p maths.Vector2) bool {
+		if !bounds.ContainsVec(p) {
+			return false
+		}
+		x, y := cellOf(p)
+		for j := clampInt(y-2, 0, rows-1); j <= clampInt(y+2, 0, rows-1); j++ {
+			for i := clampInt(x-2, 0, cols-1); i <= clampInt(x+2, 0, cols-1); i++ {
+				if n := grid[j*cols+i]; n >= 0 && (*results)[start+n].Distance2(p) < radius*radius {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	add(r.PointInRectangle(bounds))
+	active := []int{0}
+	for len(active) > 0 {
+		a := r.Intn(len(active))
+		origin := (*results)[start+active[a]]
+
+		found := false
+		for k := 0; k < PoissonAttempts; k++ {
+			// uniform in the annulus between radius and twice the radius
+			distance := radius * math.Sqrt(1+3*r.Float64())
+			candidate := origin.Add(r.UnitVector2().Multiply(distance))
+			if fits(candidate) {
+				add(candidate)
+				active = append(active, len(*results)-start-1)
+				found = true
+				break
+			}
+		}
+		if !found {
+			active[a] = active[len(active)-1]
+			active = active[:len(active)-1]
+		}
+	}
+}
+
+func clampInt(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
+// PoissonSampler places evenly spaced random points where the spacing can vary across the region,
+// for example to thin out foliage towards the edge of a forest.
+type PoissonSampler struct {
+	// Bounds encloses the region.
+	Bounds maths.Rectangle
+	// Contains limits the region within Bounds, such as polygon.Polygon.ContainsVec.
+	// A nil Contains accepts all of Bounds.
+	Contains func(v maths.Vector2) bool
+	// Radius is the minimum distance from a point at v to its neighbours.
+	Radius func(v maths.Vector2) float64
+	// Attempts is how many candidates are tried around each point, and how many random
+	// points are tried to start a new patch once growth stops.
+	Attempts int
+
+	tree       *space.QuadTree
+	treeBounds maths.Rectangle
+	entries    []space.QuadTreeEntry
+}
+
+func NewPoissonSampler(bounds maths.Rectangle, radius float64) *PoissonSampler {
+	return &PoissonSampler{
+		Bounds:   bounds,
+		Radius:   func(maths.Vector2) float64 { return radius },
+		Attempts: PoissonAttempts,
+	}
+}
+
+// DensityRadius returns a Radius function that maps density, between 0 and 1, to a spacing
+// between maxRadius at density 0 and minRadius at density 1.
+func DensityRadius(minRadius, maxRadius float64, density func(v maths.Vector2) float64) func(v maths.Vector2) float64 {
+	return func(v maths.Vector2) float64 {
+		d := density(v)
+		if d < 0 {
+			d = 0
+		} else if d > 1 {
+			d = 1
+		}
+		return maxRadius + (minRadius-maxRadius)*d
+	}
+}
+
+// Sample appends points to results using Bridson's algorithm. Points are kept in a QuadTree as
+// squares the size of their radius, so neighbours with a larger spacing are still found.
+// Two points are never closer than the larger of their radii.
+func (s *PoissonSampler) Sample(results *[]maths.Vector2, r *Rand) {
+	if s.Radius == nil || s.Bounds.Width <= 0 || s.Bounds.Height <= 0 {
+		return
+	}
+	if s.tree == nil || s.treeBounds != s.Bounds {
+		s.tree = space.NewQuadTree(s.Bounds)
+		s.treeBounds = s.Bounds
+	} else {
+		s.tree.Clear()
+	}
+
+	start := len(*results)
+	var radii []float64
+	var active []int
+	add := func(p maths.Vector2, radius float64) {
+		id := len(*results) - start
+		s.tree.Insert(space.QuadTreeEntry{ID: uint64(id), Rect: square(p, radius)})
+		*results = append(*results, p)
+		radii = append(radii, radius)
+		active = append(active, id)
+	}
+	candidate := func(p maths.Vector2) (float64, bool) {
+		if !s.Bounds.ContainsVec(p) || (s.Contains != nil && !s.Contains(p)) {
+			return 0, false
+		}
+		radius := s.Radius(p)
+		if radius <= 0 {
+			return 0, false
+		}
+		s.entries = s.entries[:0]
+		s.tree.Scan(&s.entries, square(p, radius))
+		for _, e := range s.entries {
+			spacing := math.Max(radius, radii[e.ID])
+			if (*results)[start+int(e.ID)].Distance2(p) < spacing*spacing {
+				return 0, false
+			}
+		}
+		return radius, true
+	}
+
+	for {
+		// start a new patch, which also reaches parts of the region that growth cannot jump to
+		seeded := false
+		for k := 0; k < s.Attempts && !seeded; k++ {
+			p := r.PointInRectangle(s.Bounds)
+			if radius, ok := candidate(p); ok {
+				add(p, radius)
+				seeded = true
+			}
+		}
+		if !seeded {
+			return
+		}
+
+		for len(active) > 0 {
+			a := r.Intn(len(active))
+			origin, originRadius := (*results)[start+active[a]], radii[active[a]]
+
+			found := false
+			for k := 0; k < s.Attempts; k++ {
+				p := origin.Add(r.UnitVector2().Multiply(originRadius * math.Sqrt(1+3*r.Float64())))
+				if radius, ok := candidate(p); ok {
+					add(p, radius)
+					found = true
+					break
+				}
+			}
+			if !found {
+				active[a] = active[len(active)-1]
+				active = active[:len(active)-1]
+			}
+		}
+	}
+}
+
+func square(center maths.Vector2, radius float64) maths.Rectangle {
+	return maths.Rectangle{X: center.X - radius, Y: center.Y - radius, Width: radius * 2, Height: radius * 2}
+}
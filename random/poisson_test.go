@@ -0,0 +1,102 @@
+package random_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/maths/polygon"
+	"github.com/soupstoregames/gamelib/random"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertPoissonSpacing(t *testing.T, s *random.PoissonSampler, points []maths.Vector2) {
+	t.Helper()
+	for i, p := range points {
+		assert.True(t, s.Bounds.ContainsVec(p))
+		if s.Contains != nil {
+			assert.True(t, s.Contains(p))
+		}
+		for _, q := range points[i+1:] {
+			assert.GreaterOrEqual(t, p.Distance(q), math.Max(s.Radius(p), s.Radius(q)))
+		}
+	}
+}
+
+func TestPoissonSampler_Rectangle(t *testing.T) {
+	s := random.NewPoissonSampler(maths.Rectangle{X: -50, Y: -50, Width: 100, Height: 100}, 4)
+	var points []maths.Vector2
+	s.Sample(&points, random.New(1))
+	assert.Greater(t, len(points), 300)
+	assertPoissonSpacing(t, s, points)
+
+	// the sampler can be reused with different bounds
+	s.Bounds = maths.Rectangle{X: 200, Y: 0, Width: 20, Height: 20}
+	points = points[:0]
+	s.Sample(&points, random.New(1))
+	assert.Greater(t, len(points), 10)
+	assertPoissonSpacing(t, s, points)
+}
+
+func TestPoissonSampler_Polygon(t *testing.T) {
+	region := polygon.Polygon{
+		Outer: []maths.Vector2{{X: 0, Y: 0}, {X: 60, Y: 0}, {X: 60, Y: 60}, {X: 0, Y: 60}},
+		Holes: [][]maths.Vector2{{{X: 20, Y: 20}, {X: 20, Y: 40}, {X: 40, Y: 40}, {X: 40, Y: 20}}},
+	}
+	s := random.NewPoissonSampler(region.Bounds(), 3)
+	s.Contains = region.ContainsVec
+
+	var points []maths.Vector2
+	s.Sample(&points, random.New(2))
+	assertPoissonSpacing(t, s, points)
+
+	inHole := 0
+	for _, p := range points {
+		if p.X > 20 && p.X < 40 && p.Y > 20 && p.Y < 40 {
+			inHole++
+		}
+	}
+	assert.Zero(t, inHole)
+	assert.Greater(t, len(points), 150)
+}
+
+func TestPoissonSampler_Disconnected(t *testing.T) {
+	left := maths.Rectangle{X: 0, Y: 0, Width: 10, Height: 10}
+	right := maths.Rectangle{X: 90, Y: 0, Width: 10, Height: 10}
+	s := random.NewPoissonSampler(maths.Rectangle{X: 0, Y: 0, Width: 100, Height: 10}, 2)
+	s.Contains = func(v maths.Vector2) bool { return left.ContainsVec(v) || right.ContainsVec(v) }
+
+	var points []maths.Vector2
+	s.Sample(&points, random.New(3))
+	assertPoissonSpacing(t, s, points)
+
+	var inLeft, inRight int
+	for _, p := range points {
+		if left.ContainsVec(p) {
+			inLeft++
+		} else {
+			inRight++
+		}
+	}
+	assert.Greater(t, inLeft, 5)
+	assert.Greater(t, inRight, 5)
+}
+
+func TestPoissonSampler_Density(t *testing.T) {
+	s := random.NewPoissonSampler(maths.Rectangle{X: 0, Y: 0, Width: 100, Height: 50}, 0)
+	s.Radius = random.DensityRadius(2, 8, func(v maths.Vector2) float64 { return 1 - v.X/100 })
+
+	var points []maths.Vector2
+	s.Sample(&points, random.New(4))
+	assertPoissonSpacing(t, s, points)
+
+	var dense, sparse int
+	for _, p := range points {
+		if p.X < 50 {
+			dense++
+		} else {
+			sparse++
+		}
+	}
+	assert.Greater(t, dense, sparse*2)
+}
@@ -0,0 +1,143 @@
+// Package random is a small, fast and reproducible random source for game logic.
+//
+// Rand produces the same sequence for a seed on every platform, and its state can be copied or
+// serialised, so replays and rollback can restore it exactly.
+package random
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+)
+
+// ErrInvalidState is returned when unmarshalling data that is not a Rand state.
+var ErrInvalidState = errors.New("random: invalid state")
+
+// Rand is a xoshiro256** generator. It is not safe for concurrent use.
+// Copying a Rand snapshots its state.
+type Rand struct {
+	state [4]uint64
+}
+
+func New(seed uint64) *Rand {
+	r := &Rand{}
+	r.Seed(seed)
+	return r
+}
+
+// Seed resets the generator, expanding the seed with splitmix64 so similar seeds give
+// unrelated sequences.
+func (r *Rand) Seed(seed uint64) {
+	for i := range r.state {
+		seed += 0x9e3779b97f4a7c15
+		z := seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		r.state[i] = z ^ (z >> 31)
+	}
+}
+
+func (r *Rand) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 32)
+	for i, s := range r.state {
+		binary.LittleEndian.PutUint64(data[i*8:], s)
+	}
+	return data, nil
+}
+
+func (r *Rand) UnmarshalBinary(data []byte) error {
+	if len(data) != 32 {
+		return ErrInvalidState
+	}
+	var state [4]uint64
+	for i := range state {
+		state[i] = binary.LittleEndian.Uint64(data[i*8:])
+	}
+	// an all zero state only ever produces zeros
+	if state == [4]uint64{} {
+		return ErrInvalidState
+	}
+	r.state = state
+	return nil
+}
+
+func (r *Rand) Uint64() uint64 {
+	s := &r.state
+	result := bits.RotateLeft64(s[1]*5, 7) * 9
+	t := s[1] << 17
+	s[2] ^= s[0]
+	s[3] ^= s[1]
+	s[1] ^= s[2]
+	s[0] ^= s[3]
+	s[2] ^= t
+	s[3] = bits.RotateLeft64(s[3], 45)
+	return result
+}
+
+// Uint64n returns a uniform value in [0, n) without modulo bias. It returns 0 when n is 0.
+func (r *Rand) Uint64n(n uint64) uint64 {
+	if n == 0 {
+		return 0
+	}
+	// Lemire's multiply and reject
+	hi, lo := bits.Mul64(r.Uint64(), n)
+	if lo < n {
+		threshold := -n % n
+		for lo < threshold {
+			hi, lo = bits.Mul64(r.Uint64(), n)
+		}
+	}
+	return hi
+}
+
+// Intn returns a uniform value in [0, n). It returns 0 when n <= 0.
+func (r *Rand) Intn(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	return int(r.Uint64n(uint64(n)))
+}
+
+// IntRange returns a uniform value in [min, max], both inclusive.
+func (r *Rand) IntRange(min, max int) int {
+	if max < min {
+		min, max = max, min
+	}
+	span := uint64(max-min) + 1
+	if span == 0 {
+		// the range covers every int
+		return int(r.Uint64())
+	}
+	return min + int(r.Uint64n(span))
+}
+
+// Float64 returns a uniform value in [0, 1).
+func (r *Rand) Float64() float64 {
+	return float64(r.Uint64()>>11) / (1 << 53)
+}
+
+// FloatRange returns a uniform value in [min, max).
+func (r *Rand) FloatRange(min, max float64) float64 {
+	return min + r.Float64()*(max-min)
+}
+
+func (r *Rand) Bool() bool {
+	return r.Uint64()>>63 == 1
+}
+
+// Chance returns true with probability p.
+func (r *Rand) Chance(p float64) bool {
+	return r.Float64() < p
+}
+
+// Gaussian returns a normally distributed value using the Marsaglia polar method.
+func (r *Rand) Gaussian(mean, stddev float64) float64 {
+	for {
+		u, v := r.Float64()*2-1, r.Float64()*2-1
+		s := u*u + v*v
+		if s > 0 && s < 1 {
+			return mean + stddev*u*math.Sqrt(-2*math.Log(s)/s)
+		}
+	}
+}
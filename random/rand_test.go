@@ -0,0 +1,153 @@
+package random_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/random"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRand_Deterministic(t *testing.T) {
+	a, b := random.New(1), random.New(1)
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, a.Uint64(), b.Uint64())
+	}
+
+	// fixed outputs guard against changes to the generator
+	r := random.New(0)
+	assert.Equal(t, []uint64{0x99ec5f36cb75f2b4, 0xbf6e1f784956452a, 0x1a5f849d4933e6e0}, []uint64{r.Uint64(), r.Uint64(), r.Uint64()})
+}
+
+func TestRand_Snapshot(t *testing.T) {
+	r := random.New(99)
+	r.Uint64()
+
+	data, err := r.MarshalBinary()
+	assert.NoError(t, err)
+	copied := *r
+	expected := []uint64{r.Uint64(), r.Uint64(), r.Uint64()}
+
+	restored := &random.Rand{}
+	assert.NoError(t, restored.UnmarshalBinary(data))
+	assert.Equal(t, expected, []uint64{restored.Uint64(), restored.Uint64(), restored.Uint64()})
+	assert.Equal(t, expected, []uint64{copied.Uint64(), copied.Uint64(), copied.Uint64()})
+
+	assert.ErrorIs(t, restored.UnmarshalBinary(data[:8]), random.ErrInvalidState)
+	assert.ErrorIs(t, restored.UnmarshalBinary(make([]byte, 32)), random.ErrInvalidState)
+}
+
+func TestRand_Ranges(t *testing.T) {
+	r := random.New(2)
+	counts := make([]int, 6)
+	for i := 0; i < 60000; i++ {
+		v := r.IntRange(-2, 3)
+		assert.GreaterOrEqual(t, v, -2)
+		assert.LessOrEqual(t, v, 3)
+		counts[v+2]++
+
+		f := r.FloatRange(5, 7)
+		assert.GreaterOrEqual(t, f, 5.0)
+		assert.Less(t, f, 7.0)
+	}
+	for _, c := range counts {
+		assert.InDelta(t, 10000, c, 500)
+	}
+
+	assert.Equal(t, 0, r.Intn(0))
+	assert.Equal(t, 4, r.IntRange(4, 4))
+
+	// the full range spans more values than fit in a uint64 count
+	var negative, positive bool
+	for i := 0; i < 100; i++ {
+		v := r.IntRange(math.MinInt, math.MaxInt)
+		negative = negative || v < 0
+		positive = positive || v > 0
+	}
+	assert.True(t, negative)
+	assert.True(t, positive)
+}
+
+func TestRand_Gaussian(t *testing.T) {
+	r := random.New(3)
+	var sum, sum2 float64
+	const n = 50000
+	for i := 0; i < n; i++ {
+		v := r.Gaussian(10, 2)
+		sum += v
+		sum2 += v * v
+	}
+	mean := sum / n
+	assert.InDelta(t, 10, mean, 0.05)
+	assert.InDelta(t, 2, math.Sqrt(sum2/n-mean*mean), 0.05)
+}
+
+func TestRand_Choice(t *testing.T) {
+	r := random.New(4)
+
+	s := []string{"a", "b", "c", "d", "e"}
+	random.Shuffle(r, s)
+	assert.ElementsMatch(t, []string{"a", "b", "c", "d", "e"}, s)
+
+	_, ok := random.Choice(r, []int{})
+	assert.False(t, ok)
+	v, ok := random.Choice(r, []int{7})
+	assert.True(t, ok)
+	assert.Equal(t, 7, v)
+
+	counts := map[string]int{}
+	for i := 0; i < 40000; i++ {
+		item, ok := random.WeightedChoice(r, []string{"common", "never", "rare"}, []float64{3, 0, 1})
+		assert.True(t, ok)
+		counts[item]++
+	}
+	assert.InDelta(t, 30000, counts["common"], 600)
+	assert.InDelta(t, 10000, counts["rare"], 600)
+	assert.Zero(t, counts["never"])
+
+	_, ok = r.WeightedIndex([]float64{0, -1})
+	assert.False(t, ok)
+}
+
+func TestRand_Geometry(t *testing.T) {
+	r := random.New(5)
+	rect := maths.Rectangle{X: -5, Y: 10, Width: 4, Height: 2}
+	circle := maths.NewCircle(maths.Vector2{X: 3, Y: 3}, 2)
+	sphere := maths.NewSphere(maths.Vector3{X: 1, Y: 2, Z: 3}, 5)
+
+	insideHalf := 0
+	for i := 0; i < 10000; i++ {
+		assert.InDelta(t, 1, r.UnitVector2().Magnitude(), 1e-9)
+		assert.InDelta(t, 1, r.UnitVector3().Magnitude(), 1e-9)
+		assert.True(t, rect.ContainsVec(r.PointInRectangle(rect)))
+		assert.True(t, sphere.ContainsVec(r.PointInSphere(sphere)))
+
+		p := r.PointInCircle(circle)
+		assert.True(t, circle.ContainsVec(p))
+		if p.Distance(circle.Center) < circle.Radius/math.Sqrt2 {
+			insideHalf++
+		}
+	}
+	// half the area lies within radius/sqrt(2)
+	assert.InDelta(t, 5000, insideHalf, 250)
+}
+
+func TestRand_PoissonDisk(t *testing.T) {
+	r := random.New(6)
+	bounds := maths.Rectangle{X: 10, Y: 20, Width: 100, Height: 50}
+	const radius = 5
+
+	points := []maths.Vector2{{X: -1, Y: -1}}
+	r.PoissonDisk(&points, bounds, radius)
+	points = points[1:]
+
+	// a maximal packing covers the area densely
+	assert.Greater(t, len(points), 120)
+	for i, p := range points {
+		assert.True(t, bounds.ContainsVec(p))
+		for _, q := range points[i+1:] {
+			assert.GreaterOrEqual(t, p.Distance(q), float64(radius))
+		}
+	}
+}
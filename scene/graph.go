@@ -0,0 +1,201 @@
+package scene
+
+import (
+	"github.com/soupstoregames/gamelib/data"
+)
+
+// Transform is the local transform of a node, which produces its matrix M.
+type Transform[M any] interface {
+	Matrix() M
+}
+
+// Matrix is a transform matrix that can be combined with its parent's.
+type Matrix[M any] interface {
+	Multiply(m M) M
+}
+
+type Node[T Transform[M], M Matrix[M]] struct {
+	ID    uint64
+	Local T
+
+	world      M
+	parent     int32
+	firstChild int32
+	next       int32
+	flags      data.Bitfield1[uint8]
+}
+
+// Graph is a hierarchy of transforms of type T, whose world matrices are of type M.
+// World matrices are cached and only recomputed when a node or one of its ancestors has changed.
+type Graph[T Transform[M], M Matrix[M]] struct {
+	nodes data.FreeList[Node[T, M]]
+}
+
+func NewGraph[T Transform[M], M Matrix[M]]() *Graph[T, M] {
+	return &Graph[T, M]{
+		nodes: data.NewFreeList[Node[T, M]](),
+	}
+}
+
+// Insert adds a new node at the top of the hierarchy.
+func (g *Graph[T, M]) Insert(id uint64, local T) int {
+	node := Node[T, M]{ID: id, Local: local, parent: -1, firstChild: -1, next: -1}
+	node.flags.Set(NodeFlagDirty)
+	return g.nodes.Insert(node)
+}
+
+// Remove removes the node and all of its descendants.
+func (g *Graph[T, M]) Remove(nodeID int) {
+	g.Detach(nodeID)
+	g.remove(nodeID)
+}
+
+func (g *Graph[T, M]) remove(nodeID int) {
+	node := g.nodes.Get(nodeID)
+	childID := node.firstChild
+	for {
+		if childID == -1 {
+			break
+		}
+		next := g.nodes.Get(int(childID)).next
+		g.remove(int(childID))
+		childID = next
+	}
+
+	node.flags.Set(NodeFlagRemoved)
+	g.nodes.Set(nodeID, node)
+	g.nodes.Erase(nodeID)
+}
+
+func (g *Graph[T, M]) Get(nodeID int) Node[T, M] {
+	return g.nodes.Get(nodeID)
+}
+
+// Attach makes nodeID a child of parentID, keeping its local transform.
+// It returns false if parentID is nodeID or one of its descendants.
+func (g *Graph[T, M]) Attach(nodeID, parentID int) bool {
+	for ancestorID := parentID; ancestorID != -1; ancestorID = int(g.nodes.Get(ancestorID).parent) {
+		if ancestorID == nodeID {
+			return false
+		}
+	}
+
+	g.Detach(nodeID)
+
+	parent := g.nodes.Get(parentID)
+	node := g.nodes.Get(nodeID)
+	node.parent = int32(parentID)
+	node.next = parent.firstChild
+	parent.firstChild = int32(nodeID)
+	g.nodes.Set(parentID, parent)
+	g.nodes.Set(nodeID, node)
+
+	g.markDirty(nodeID)
+	return true
+}
+
+// Detach moves the node to the top of the hierarchy, keeping its local transform.
+func (g *Graph[T, M]) Detach(nodeID int) {
+	node := g.nodes.Get(nodeID)
+	if node.parent == -1 {
+		return
+	}
+
+	parentID := int(node.parent)
+	parent := g.nodes.Get(parentID)
+	if int(parent.firstChild) == nodeID {
+		parent.firstChild = node.next
+		g.nodes.Set(parentID, parent)
+	} else {
+		childID := int(parent.firstChild)
+		for {
+			if childID == -1 {
+				break
+			}
+			child := g.nodes.Get(childID)
+			if int(child.next) == nodeID {
+				child.next = node.next
+				g.nodes.Set(childID, child)
+				break
+			}
+			childID = int(child.next)
+		}
+	}
+
+	node = g.nodes.Get(nodeID)
+	node.parent = -1
+	node.next = -1
+	g.nodes.Set(nodeID, node)
+
+	g.markDirty(nodeID)
+}
+
+// Parent returns the parent of the node or -1 if it is at the top of the hierarchy.
+func (g *Graph[T, M]) Parent(nodeID int) int {
+	return int(g.nodes.Get(nodeID).parent)
+}
+
+func (g *Graph[T, M]) Children(children *[]int, nodeID int) {
+	childID := g.nodes.Get(nodeID).firstChild
+	for {
+		if childID == -1 {
+			break
+		}
+		*children = append(*children, int(childID))
+		childID = g.nodes.Get(int(childID)).next
+	}
+}
+
+func (g *Graph[T, M]) SetLocal(nodeID int, local T) {
+	node := g.nodes.Get(nodeID)
+	node.Local = local
+	g.nodes.Set(nodeID, node)
+	g.markDirty(nodeID)
+}
+
+// World returns the matrix that transforms from the node's local space to world space.
+func (g *Graph[T, M]) World(nodeID int) M {
+	node := g.nodes.Get(nodeID)
+	if !node.flags.Has(NodeFlagDirty) {
+		return node.world
+	}
+
+	node.world = node.Local.Matrix()
+	if node.parent != -1 {
+		node.world = g.World(int(node.parent)).Multiply(node.world)
+	}
+	node.flags.Clear(NodeFlagDirty)
+	g.nodes.Set(nodeID, node)
+	return node.world
+}
+
+// Update recomputes the world matrix of every dirty node.
+func (g *Graph[T, M]) Update() {
+	for i := 0; i < g.nodes.Len(); i++ {
+		node := g.nodes.Get(i)
+		if node.flags.Has(NodeFlagRemoved) || !node.flags.Has(NodeFlagDirty) {
+			continue
+		}
+		g.World(i)
+	}
+}
+
+// markDirty flags the node and its descendants for recomputation.
+// Descendants of a dirty node are always dirty, so the walk stops at nodes that are already flagged.
+func (g *Graph[T, M]) markDirty(nodeID int) {
+	node := g.nodes.Get(nodeID)
+	if node.flags.Has(NodeFlagDirty) {
+		return
+	}
+	node.flags.Set(NodeFlagDirty)
+	g.nodes.Set(nodeID, node)
+
+	childID := node.firstChild
+	for {
+		if childID == -1 {
+			break
+		}
+		g.markDirty(int(childID))
+		childID = g.nodes.Get(int(childID)).next
+	}
+}
@@ -0,0 +1,57 @@
+package scene
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"math"
+)
+
+type Node2D = Node[maths.Transform2D, maths.Matrix3]
+
+// Graph2D is a hierarchy of 2D transforms.
+type Graph2D struct {
+	Graph[maths.Transform2D, maths.Matrix3]
+}
+
+func NewGraph2D() *Graph2D {
+	return &Graph2D{Graph: *NewGraph[maths.Transform2D, maths.Matrix3]()}
+}
+
+func (g *Graph2D) WorldPosition(nodeID int) maths.Vector2 {
+	return g.World(nodeID).MultiplyPoint(maths.Vector2{})
+}
+
+// WorldRect returns the axis aligned bounds of a rectangle in the node's local space.
+func (g *Graph2D) WorldRect(nodeID int, local maths.Rectangle) maths.Rectangle {
+	world := g.World(nodeID)
+	corners := [4]maths.Vector2{
+		{X: local.X, Y: local.Y},
+		{X: local.X + local.Width, Y: local.Y},
+		{X: local.X, Y: local.Y + local.Height},
+		{X: local.X + local.Width, Y: local.Y + local.Height},
+	}
+
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, c := range corners {
+		p := world.MultiplyPoint(c)
+		minX = math.Min(minX, p.X)
+		minY = math.Min(minY, p.Y)
+		maxX = math.Max(maxX, p.X)
+		maxY = math.Max(maxY, p.Y)
+	}
+	return maths.Rectangle{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+// WorldCircle returns a circle in world space that bounds a circle in the node's local space.
+// Non-uniform scales produce a circle that fits the largest axis.
+func (g *Graph2D) WorldCircle(nodeID int, local maths.Circle) maths.Circle {
+	world := g.World(nodeID)
+	scale := math.Max(
+		world.MultiplyDirection(maths.Vector2{X: 1}).Magnitude(),
+		world.MultiplyDirection(maths.Vector2{Y: 1}).Magnitude(),
+	)
+	return maths.Circle{
+		Center: world.MultiplyPoint(local.Center),
+		Radius: local.Radius * scale,
+	}
+}
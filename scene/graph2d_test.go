@@ -0,0 +1,98 @@
+package scene_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/scene"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func assertVector2InDelta(t *testing.T, expected, actual maths.Vector2) {
+	t.Helper()
+	assert.InDelta(t, expected.X, actual.X, 1e-9)
+	assert.InDelta(t, expected.Y, actual.Y, 1e-9)
+}
+
+func TestGraph2D_World(t *testing.T) {
+	graph := scene.NewGraph2D()
+
+	ship := graph.Insert(1, maths.NewTransform2D(maths.Vector2{X: 10, Y: 0}))
+	turret := graph.Insert(2, maths.NewTransform2D(maths.Vector2{X: 2, Y: 0}))
+	barrel := graph.Insert(3, maths.NewTransform2D(maths.Vector2{X: 1, Y: 0}))
+	assert.True(t, graph.Attach(turret, ship))
+	assert.True(t, graph.Attach(barrel, turret))
+
+	assertVector2InDelta(t, maths.Vector2{X: 13}, graph.WorldPosition(barrel))
+
+	// rotating the ship swings everything attached to it
+	local := graph.Get(ship).Local
+	local.Rotation = math.Pi / 2
+	graph.SetLocal(ship, local)
+	graph.Update()
+	assertVector2InDelta(t, maths.Vector2{X: 10, Y: 2}, graph.WorldPosition(turret))
+	assertVector2InDelta(t, maths.Vector2{X: 10, Y: 3}, graph.WorldPosition(barrel))
+
+	// scaling the turret only affects its children
+	local = graph.Get(turret).Local
+	local.Scale = maths.Vector2{X: 2, Y: 2}
+	graph.SetLocal(turret, local)
+	assertVector2InDelta(t, maths.Vector2{X: 10, Y: 2}, graph.WorldPosition(turret))
+	assertVector2InDelta(t, maths.Vector2{X: 10, Y: 4}, graph.WorldPosition(barrel))
+
+	graph.Detach(turret)
+	assert.Equal(t, -1, graph.Parent(turret))
+	assertVector2InDelta(t, maths.Vector2{X: 2}, graph.WorldPosition(turret))
+	assertVector2InDelta(t, maths.Vector2{X: 4}, graph.WorldPosition(barrel))
+}
+
+func TestGraph2D_Attach(t *testing.T) {
+	graph := scene.NewGraph2D()
+	a := graph.Insert(1, maths.NewTransform2D(maths.Vector2{}))
+	b := graph.Insert(2, maths.NewTransform2D(maths.Vector2{}))
+	c := graph.Insert(3, maths.NewTransform2D(maths.Vector2{}))
+
+	assert.True(t, graph.Attach(b, a))
+	assert.True(t, graph.Attach(c, a))
+	assert.False(t, graph.Attach(a, a))
+	assert.False(t, graph.Attach(a, b))
+
+	var children []int
+	graph.Children(&children, a)
+	assert.ElementsMatch(t, []int{b, c}, children)
+
+	assert.True(t, graph.Attach(c, b))
+	children = children[:0]
+	graph.Children(&children, a)
+	assert.ElementsMatch(t, []int{b}, children)
+	assert.Equal(t, b, graph.Parent(c))
+
+	graph.Remove(b)
+	children = children[:0]
+	graph.Children(&children, a)
+	assert.Empty(t, children)
+
+	// removed slots are reused
+	d := graph.Insert(4, maths.NewTransform2D(maths.Vector2{}))
+	assert.Contains(t, []int{b, c}, d)
+	graph.Update()
+}
+
+func TestGraph2D_WorldBounds(t *testing.T) {
+	graph := scene.NewGraph2D()
+	parent := graph.Insert(1, maths.Transform2D{
+		Position: maths.Vector2{X: 5, Y: 5},
+		Rotation: math.Pi / 2,
+		Scale:    maths.Vector2{X: 2, Y: 1},
+	})
+
+	rect := graph.WorldRect(parent, maths.Rectangle{X: 0, Y: 0, Width: 1, Height: 1})
+	assert.InDelta(t, 4, rect.X, 1e-9)
+	assert.InDelta(t, 5, rect.Y, 1e-9)
+	assert.InDelta(t, 1, rect.Width, 1e-9)
+	assert.InDelta(t, 2, rect.Height, 1e-9)
+
+	circle := graph.WorldCircle(parent, maths.Circle{Center: maths.Vector2{X: 1}, Radius: 1})
+	assertVector2InDelta(t, maths.Vector2{X: 5, Y: 7}, circle.Center)
+	assert.InDelta(t, 2, circle.Radius, 1e-9)
+}
@@ -0,0 +1,38 @@
+package scene
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"math"
+)
+
+type Node3D = Node[maths.Transform3D, maths.Matrix4]
+
+// Graph3D is a hierarchy of 3D transforms.
+type Graph3D struct {
+	Graph[maths.Transform3D, maths.Matrix4]
+}
+
+func NewGraph3D() *Graph3D {
+	return &Graph3D{Graph: *NewGraph[maths.Transform3D, maths.Matrix4]()}
+}
+
+func (g *Graph3D) WorldPosition(nodeID int) maths.Vector3 {
+	return g.World(nodeID).MultiplyPoint(maths.Vector3{})
+}
+
+// WorldSphere returns a sphere in world space that bounds a sphere in the node's local space.
+// Non-uniform scales produce a sphere that fits the largest axis.
+func (g *Graph3D) WorldSphere(nodeID int, local maths.Sphere) maths.Sphere {
+	world := g.World(nodeID)
+	scale := math.Max(
+		world.MultiplyDirection(maths.Vector3{X: 1}).Magnitude(),
+		math.Max(
+			world.MultiplyDirection(maths.Vector3{Y: 1}).Magnitude(),
+			world.MultiplyDirection(maths.Vector3{Z: 1}).Magnitude(),
+		),
+	)
+	return maths.Sphere{
+		Center: world.MultiplyPoint(local.Center),
+		Radius: local.Radius * scale,
+	}
+}
@@ -0,0 +1,32 @@
+package scene_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/scene"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestGraph3D_World(t *testing.T) {
+	graph := scene.NewGraph3D()
+
+	character := graph.Insert(1, maths.NewTransform3D(maths.Vector3{X: 10}))
+	weapon := graph.Insert(2, maths.NewTransform3D(maths.Vector3{Z: 1}))
+	assert.True(t, graph.Attach(weapon, character))
+
+	local := graph.Get(character).Local
+	local.Rotation = maths.NewQuaternionAxisAngle(maths.Vector3{Y: 1}, math.Pi/2)
+	graph.SetLocal(character, local)
+
+	p := graph.WorldPosition(weapon)
+	assert.InDelta(t, 11, p.X, 1e-9)
+	assert.InDelta(t, 0, p.Y, 1e-9)
+	assert.InDelta(t, 0, p.Z, 1e-9)
+
+	local.Scale = maths.Vector3{X: 1, Y: 3, Z: 1}
+	graph.SetLocal(character, local)
+	sphere := graph.WorldSphere(weapon, maths.Sphere{Radius: 1})
+	assert.InDelta(t, 11, sphere.Center.X, 1e-9)
+	assert.InDelta(t, 3, sphere.Radius, 1e-9)
+}
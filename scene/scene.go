@@ -0,0 +1,6 @@
+package scene
+
+const (
+	NodeFlagDirty = iota
+	NodeFlagRemoved
+)
@@ -15,6 +15,11 @@ type CircleTree struct {
 	maxBranchSize float64
 	maxLeafSize   float64
 	gravy         float64
+
+	// TightBounds makes recompute fit each super circle exactly around its children instead of
+	// centering it on their average position. It costs more per recompute but gives smaller bounds.
+	TightBounds bool
+	children    []maths.Circle
 }
 
 const (
@@ -162,6 +167,33 @@ func (st *CircleTree) Scan(entries *[]CircleEntry, selectionCircle maths.Circle)
 	}
 }
 
+// Sweep moves circle by delta and returns the entry it hits first, along with the hit.
+// Entries rejected by filter are ignored; a nil filter accepts every entry.
+func (st *CircleTree) Sweep(circle maths.Circle, delta maths.Vector2, filter func(e CircleEntry) bool) (CircleEntry, maths.Hit, bool) {
+	// scan a circle that covers the whole path
+	area := maths.Circle{
+		Center: circle.Center.Add(delta.Multiply(0.5)),
+		Radius: circle.Radius + delta.Magnitude()/2,
+	}
+
+	var candidates []CircleEntry
+	st.Scan(&candidates, area)
+
+	var best CircleEntry
+	var bestHit maths.Hit
+	found := false
+	for _, entry := range candidates {
+		if filter != nil && !filter(entry) {
+			continue
+		}
+		hit, ok := maths.SweepCircleCircle(circle, delta, entry.Circle)
+		if ok && (!found || hit.Time < bestHit.Time) {
+			best, bestHit, found = entry, hit, true
+		}
+	}
+	return best, bestHit, found
+}
+
 func (st *CircleTree) queueIntegrate(entryID int) {
 	entry := st.circles.Get(entryID)
 
@@ -320,6 +352,11 @@ func (st *CircleTree) recompute(superCircleID int) {
 		return
 	}
 
+	if st.TightBounds {
+		st.recomputeTight(superCircleID, superCircle)
+		return
+	}
+
 	var childCount int
 	var total maths.Vector2
 	childIndex := superCircle.firstChild
@@ -333,8 +370,8 @@ func (st *CircleTree) recompute(superCircleID int) {
 		total = total.Add(child.Circle.Center)
 		childIndex = child.next
 	}
-	recip := 1.0 / float64(childCount)
 	oldCenter := superCircle.Circle.Center
+	recip := 1.0 / float64(childCount)
 	superCircle.Circle.Center = total.Multiply(recip)
 
 	newRadius := 0.0
@@ -361,6 +398,29 @@ func (st *CircleTree) recompute(superCircleID int) {
 	st.circles.Set(superCircleID, superCircle)
 }
 
+// recomputeTight fits the super circle to the smallest circle enclosing its children.
+// Like recompute, it leaves the super circle alone if the fit would not be smaller.
+func (st *CircleTree) recomputeTight(superCircleID int, superCircle CircleEntry) {
+	st.children = st.children[:0]
+	childIndex := superCircle.firstChild
+	for {
+		if childIndex == -1 {
+			break
+		}
+		child := st.circles.Get(int(childIndex))
+		st.children = append(st.children, child.Circle)
+		childIndex = child.next
+	}
+
+	tight := maths.EnclosingCircleOfCircles(st.children)
+	if tight.Radius+st.gravy <= superCircle.Circle.Radius {
+		superCircle.Circle = tight
+		superCircle.Circle.Radius += st.gravy
+	}
+	superCircle.flags.Clear(SPFRecompute)
+	st.circles.Set(superCircleID, superCircle)
+}
+
 func (st *CircleTree) addChild(parentID, CircleID int) {
 	parent := st.circles.Get(parentID)
 	entry := st.circles.Get(CircleID)
@@ -0,0 +1,55 @@
+package space_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/space"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestCircleTree_TightBounds(t *testing.T) {
+	build := func(tight bool) *space.CircleTree {
+		r := rand.New(rand.NewSource(1))
+		ct := space.NewCircleTree(maths.Vector2{X: 500, Y: 500}, 300, 80, 1)
+		ct.TightBounds = tight
+		for i := 0; i < 500; i++ {
+			ct.Insert(uint64(i), maths.NewCircle(maths.Vector2{X: r.Float64() * 1000, Y: r.Float64() * 1000}, 1+r.Float64()*4))
+		}
+		ct.Integrate()
+		ct.Recompute()
+		return ct
+	}
+	loose, tight := build(false), build(true)
+
+	leafArea := func(ct *space.CircleTree) float64 {
+		var area float64
+		ct.Walk(func(s maths.Circle, level int) {
+			if level == 1 {
+				area += s.Area()
+			}
+		})
+		return area
+	}
+	assert.Less(t, leafArea(tight), leafArea(loose))
+
+	// both trees must still find the same entries
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < 50; i++ {
+		query := maths.NewCircle(maths.Vector2{X: r.Float64() * 1000, Y: r.Float64() * 1000}, 50)
+		var expected, actual []space.CircleEntry
+		loose.Scan(&expected, query)
+		tight.Scan(&actual, query)
+		assert.Equal(t, circleIDs(expected), circleIDs(actual))
+	}
+}
+
+func circleIDs(entries []space.CircleEntry) []uint64 {
+	ids := make([]uint64, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ID
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
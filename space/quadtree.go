@@ -3,6 +3,7 @@ package space
 import (
 	"github.com/soupstoregames/gamelib/data"
 	"github.com/soupstoregames/gamelib/maths"
+	"math"
 )
 
 const (
@@ -49,7 +50,7 @@ func (q *QuadTree) Insert(e QuadTreeEntry) {
 func (q *QuadTree) insert(e QuadTreeEntry, bounds maths.Rectangle, depth int, nodeIndex int) {
 	// if the player does not exist within our bounds, do nothing.
 	// one of our siblings will accept the player
-	if !bounds.Intersects(e.Rect) {
+	if !touches(bounds, e.Rect) {
 		return
 	}
 
@@ -122,7 +123,7 @@ func (q *QuadTree) Remove(e QuadTreeEntry) {
 func (q *QuadTree) remove(e QuadTreeEntry, bounds maths.Rectangle, nodeIndex int) {
 	// if the entity does not exist within our bounds, do nothing.
 	// the entity could never have been in our tree.
-	if !bounds.Intersects(e.Rect) {
+	if !touches(bounds, e.Rect) {
 		return
 	}
 
@@ -180,7 +181,7 @@ func (q *QuadTree) Scan(results *[]QuadTreeEntry, rect maths.Rectangle) {
 }
 
 func (q *QuadTree) scan(results *[]QuadTreeEntry, rect, bounds maths.Rectangle, nodeIndex int) {
-	if !bounds.Intersects(rect) {
+	if !touches(bounds, rect) {
 		return
 	}
 
@@ -214,6 +215,49 @@ func (q *QuadTree) scan(results *[]QuadTreeEntry, rect, bounds maths.Rectangle,
 	}
 }
 
+// Sweep moves rect by delta and returns the entry it hits first, along with the hit.
+// Entries rejected by filter are ignored; a nil filter accepts every entry.
+func (q *QuadTree) Sweep(rect maths.Rectangle, delta maths.Vector2, filter func(e QuadTreeEntry) bool) (QuadTreeEntry, maths.Hit, bool) {
+	var candidates []QuadTreeEntry
+	return q.SweepFunc(&candidates, rect, delta, func(rect maths.Rectangle, delta maths.Vector2, e QuadTreeEntry) (maths.Hit, bool) {
+		if filter != nil && !filter(e) {
+			return maths.Hit{}, false
+		}
+		return maths.SweepRectangle(rect, delta, e.Rect)
+	})
+}
+
+// SweepFunc is Sweep with the test against each entry left to hit, so that entries can collide
+// with a shape other than their rectangle. hit only sees entries whose rectangle lies in the path
+// and returns false for entries that are missed or ignored.
+// candidates is reset and filled with the entries in the path, so reusing it between sweeps avoids allocating.
+func (q *QuadTree) SweepFunc(candidates *[]QuadTreeEntry, rect maths.Rectangle, delta maths.Vector2, hit func(rect maths.Rectangle, delta maths.Vector2, e QuadTreeEntry) (maths.Hit, bool)) (QuadTreeEntry, maths.Hit, bool) {
+	// scan the whole area the rectangle passes through
+	moved := rect
+	moved.X += delta.X
+	moved.Y += delta.Y
+	area := maths.Rectangle{
+		X:      math.Min(rect.X, moved.X),
+		Y:      math.Min(rect.Y, moved.Y),
+		Width:  rect.Width + math.Abs(delta.X),
+		Height: rect.Height + math.Abs(delta.Y),
+	}
+
+	*candidates = (*candidates)[:0]
+	q.Scan(candidates, area)
+
+	var best QuadTreeEntry
+	var bestHit maths.Hit
+	found := false
+	for _, entry := range *candidates {
+		h, ok := hit(rect, delta, entry)
+		if ok && (!found || h.Time < bestHit.Time) {
+			best, bestHit, found = entry, h, true
+		}
+	}
+	return best, bestHit, found
+}
+
 func (q *QuadTree) CleanUp() {
 	var stack []int
 
@@ -271,6 +315,12 @@ func (q *QuadTree) Clear() {
 	q.nodes.Insert(quadTreeNode{firstChild: -1})
 }
 
+// touches is Rectangle.Intersects but also accepts rectangles that only meet at an edge, so that
+// entries without area lying on a node's edge are still stored and found.
+func touches(r, r2 maths.Rectangle) bool {
+	return !(r2.X > r.X+r.Width || r2.X+r2.Width < r.X || r2.Y > r.Y+r.Height || r2.Y+r2.Height < r.Y)
+}
+
 func (q *QuadTree) isBranchNode(node quadTreeNode) bool {
 	return node.count == -1
 }
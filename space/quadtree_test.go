@@ -3,6 +3,7 @@ package space_test
 import (
 	"github.com/soupstoregames/gamelib/maths"
 	"github.com/soupstoregames/gamelib/space"
+	"github.com/stretchr/testify/assert"
 	"math/rand"
 	"testing"
 )
@@ -135,3 +136,21 @@ func BenchmarkQuadTree_Scan(b *testing.B) {
 		})
 	}
 }
+
+func TestQuadTree_PointOnNodeEdge(t *testing.T) {
+	qt := space.NewQuadTree(maths.Rectangle{Width: 100, Height: 100})
+	// fill the tree past capacity so the root splits at 50, 50
+	for i := 0; i <= space.QuadTreeCapacity; i++ {
+		qt.Insert(space.QuadTreeEntry{ID: uint64(i), Rect: maths.Rectangle{X: float64(i), Y: float64(i), Width: 1, Height: 1}})
+	}
+	point := space.QuadTreeEntry{ID: 1000, Rect: maths.Rectangle{X: 50, Y: 50}}
+	qt.Insert(point)
+
+	var results []space.QuadTreeEntry
+	qt.Scan(&results, maths.Rectangle{X: 49, Y: 49, Width: 2, Height: 2})
+	var ids []uint64
+	for _, e := range results {
+		ids = append(ids, e.ID)
+	}
+	assert.Contains(t, ids, point.ID)
+}
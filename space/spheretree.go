@@ -15,6 +15,11 @@ type SphereTree struct {
 	maxBranchSize float64
 	maxLeafSize   float64
 	gravy         float64
+
+	// TightBounds makes recompute fit each super sphere exactly around its children instead of
+	// centering it on their average position. It costs more per recompute but gives smaller bounds.
+	TightBounds bool
+	children    []maths.Sphere
 }
 
 type SphereEntry struct {
@@ -314,6 +319,11 @@ func (st *SphereTree) recompute(superSphereID int) {
 		return
 	}
 
+	if st.TightBounds {
+		st.recomputeTight(superSphereID, superSphere)
+		return
+	}
+
 	var childCount int
 	var total maths.Vector3
 	childIndex := superSphere.firstChild
@@ -327,8 +337,8 @@ func (st *SphereTree) recompute(superSphereID int) {
 		total = total.Add(child.Sphere.Center)
 		childIndex = child.next
 	}
-	recip := 1.0 / float64(childCount)
 	oldCenter := superSphere.Sphere.Center
+	recip := 1.0 / float64(childCount)
 	superSphere.Sphere.Center = total.Multiply(recip)
 
 	newRadius := 0.0
@@ -355,6 +365,29 @@ func (st *SphereTree) recompute(superSphereID int) {
 	st.spheres.Set(superSphereID, superSphere)
 }
 
+// recomputeTight fits the super sphere to the smallest sphere enclosing its children.
+// Like recompute, it leaves the super sphere alone if the fit would not be smaller.
+func (st *SphereTree) recomputeTight(superSphereID int, superSphere SphereEntry) {
+	st.children = st.children[:0]
+	childIndex := superSphere.firstChild
+	for {
+		if childIndex == -1 {
+			break
+		}
+		child := st.spheres.Get(int(childIndex))
+		st.children = append(st.children, child.Sphere)
+		childIndex = child.next
+	}
+
+	tight := maths.EnclosingSphereOfSpheres(st.children)
+	if tight.Radius+st.gravy <= superSphere.Sphere.Radius {
+		superSphere.Sphere = tight
+		superSphere.Sphere.Radius += st.gravy
+	}
+	superSphere.flags.Clear(SPFRecompute)
+	st.spheres.Set(superSphereID, superSphere)
+}
+
 func (st *SphereTree) addChild(parentID, sphereID int) {
 	parent := st.spheres.Get(parentID)
 	entry := st.spheres.Get(sphereID)
@@ -130,3 +130,42 @@ func BenchmarkSphereTree_Scan(b *testing.B) {
 		})
 	}
 }
+
+func TestSphereTree_TightBounds(t *testing.T) {
+	build := func(tight bool) *space.SphereTree {
+		r := rand.New(rand.NewSource(1))
+		st := space.NewSphereTree(maths.Vector3{X: 500, Y: 500, Z: 500}, 400, 120, 1)
+		st.TightBounds = tight
+		for i := 0; i < 500; i++ {
+			st.Insert(uint64(i), maths.NewSphere(maths.Vector3{X: r.Float64() * 1000, Y: r.Float64() * 1000, Z: r.Float64() * 1000}, 1+r.Float64()*4))
+		}
+		st.Integrate()
+		st.Recompute()
+		return st
+	}
+	loose, tight := build(false), build(true)
+
+	leafVolume := func(st *space.SphereTree) float64 {
+		var volume float64
+		st.Walk(func(s maths.Sphere, level int) {
+			if level == 1 {
+				volume += s.Volume()
+			}
+		})
+		return volume
+	}
+	if leafVolume(tight) >= leafVolume(loose) {
+		t.Errorf("tight bounds should be smaller: %f >= %f", leafVolume(tight), leafVolume(loose))
+	}
+
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < 50; i++ {
+		query := maths.NewSphere(maths.Vector3{X: r.Float64() * 1000, Y: r.Float64() * 1000, Z: r.Float64() * 1000}, 100)
+		var expected, actual []space.SphereEntry
+		loose.Scan(&expected, query)
+		tight.Scan(&actual, query)
+		if len(expected) != len(actual) {
+			t.Errorf("query %d found %d entries, expected %d", i, len(actual), len(expected))
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package space_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/space"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestQuadTree_Sweep(t *testing.T) {
+	qt := space.NewQuadTree(maths.Rectangle{Width: 100, Height: 100})
+	qt.Insert(space.QuadTreeEntry{ID: 1, Rect: maths.Rectangle{X: 50, Y: 0, Width: 1, Height: 100}})
+	qt.Insert(space.QuadTreeEntry{ID: 2, Rect: maths.Rectangle{X: 30, Y: 0, Width: 1, Height: 100}})
+	qt.Insert(space.QuadTreeEntry{ID: 3, Rect: maths.Rectangle{X: 10, Y: 80, Width: 80, Height: 1}})
+
+	bullet := maths.Rectangle{X: 10, Y: 10, Width: 1, Height: 1}
+	entry, hit, ok := qt.Sweep(bullet, maths.Vector2{X: 80}, nil)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), entry.ID)
+	assert.InDelta(t, 19.0/80, hit.Time, 1e-9)
+
+	entry, _, ok = qt.Sweep(bullet, maths.Vector2{X: 80}, func(e space.QuadTreeEntry) bool { return e.ID != 2 })
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), entry.ID)
+
+	_, _, ok = qt.Sweep(bullet, maths.Vector2{X: 10}, nil)
+	assert.False(t, ok)
+
+	// entries can collide with their own shape, here a thin wall in the middle of each rectangle
+	var candidates []space.QuadTreeEntry
+	entry, hit, ok = qt.SweepFunc(&candidates, bullet, maths.Vector2{X: 80}, func(rect maths.Rectangle, delta maths.Vector2, e space.QuadTreeEntry) (maths.Hit, bool) {
+		wall := maths.Rectangle{X: e.Rect.X + e.Rect.Width/2, Y: e.Rect.Y, Height: e.Rect.Height}
+		return maths.SweepRectangle(rect, delta, wall)
+	})
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), entry.ID)
+	assert.InDelta(t, 19.5/80, hit.Time, 1e-9)
+	assert.Len(t, candidates, 2, "the candidates are left in the buffer")
+}
+
+func TestCircleTree_Sweep(t *testing.T) {
+	ct := space.NewCircleTree(maths.Vector2{}, 100, 20, 1)
+	ct.Insert(1, maths.NewCircle(maths.Vector2{X: 20}, 2))
+	ct.Insert(2, maths.NewCircle(maths.Vector2{X: 10}, 2))
+	ct.Insert(3, maths.NewCircle(maths.Vector2{X: 10, Y: 20}, 2))
+	ct.Integrate()
+	ct.Recompute()
+
+	entry, hit, ok := ct.Sweep(maths.NewCircle(maths.Vector2{}, 1), maths.Vector2{X: 30}, nil)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), entry.ID)
+	assert.InDelta(t, 7.0/30, hit.Time, 1e-9)
+
+	_, _, ok = ct.Sweep(maths.NewCircle(maths.Vector2{}, 1), maths.Vector2{Y: -30}, nil)
+	assert.False(t, ok)
+}
@@ -0,0 +1,167 @@
+package tween
+
+import "math"
+
+// Easing maps linear progress from 0 to 1 onto eased progress.
+// Eased progress starts at 0 and ends at 1 but may overshoot in between.
+type Easing func(t float64) float64
+
+func Linear(t float64) float64 {
+	return t
+}
+
+func InQuad(t float64) float64 {
+	return t * t
+}
+
+func OutQuad(t float64) float64 {
+	return 1 - (1-t)*(1-t)
+}
+
+func InOutQuad(t float64) float64 {
+	return inOut(InQuad, t)
+}
+
+func InCubic(t float64) float64 {
+	return t * t * t
+}
+
+func OutCubic(t float64) float64 {
+	return out(InCubic, t)
+}
+
+func InOutCubic(t float64) float64 {
+	return inOut(InCubic, t)
+}
+
+func InQuart(t float64) float64 {
+	return t * t * t * t
+}
+
+func OutQuart(t float64) float64 {
+	return out(InQuart, t)
+}
+
+func InOutQuart(t float64) float64 {
+	return inOut(InQuart, t)
+}
+
+func InQuint(t float64) float64 {
+	return t * t * t * t * t
+}
+
+func OutQuint(t float64) float64 {
+	return out(InQuint, t)
+}
+
+func InOutQuint(t float64) float64 {
+	return inOut(InQuint, t)
+}
+
+func InSine(t float64) float64 {
+	return 1 - math.Cos(t*math.Pi/2)
+}
+
+func OutSine(t float64) float64 {
+	return math.Sin(t * math.Pi / 2)
+}
+
+func InOutSine(t float64) float64 {
+	return (1 - math.Cos(t*math.Pi)) / 2
+}
+
+func InExpo(t float64) float64 {
+	if t == 0 {
+		return 0
+	}
+	return math.Pow(2, 10*t-10)
+}
+
+func OutExpo(t float64) float64 {
+	return out(InExpo, t)
+}
+
+func InOutExpo(t float64) float64 {
+	return inOut(InExpo, t)
+}
+
+func InCirc(t float64) float64 {
+	return 1 - math.Sqrt(1-t*t)
+}
+
+func OutCirc(t float64) float64 {
+	return out(InCirc, t)
+}
+
+func InOutCirc(t float64) float64 {
+	return inOut(InCirc, t)
+}
+
+// InBack pulls back slightly before moving forward.
+func InBack(t float64) float64 {
+	const overshoot = 1.70158
+	return t * t * ((overshoot+1)*t - overshoot)
+}
+
+func OutBack(t float64) float64 {
+	return out(InBack, t)
+}
+
+func InOutBack(t float64) float64 {
+	return inOut(InBack, t)
+}
+
+// InElastic oscillates with growing amplitude before snapping to the end.
+func InElastic(t float64) float64 {
+	if t == 0 || t == 1 {
+		return t
+	}
+	return -math.Pow(2, 10*t-10) * math.Sin((t*10-10.75)*2*math.Pi/3)
+}
+
+func OutElastic(t float64) float64 {
+	return out(InElastic, t)
+}
+
+func InOutElastic(t float64) float64 {
+	return inOut(InElastic, t)
+}
+
+func InBounce(t float64) float64 {
+	return out(OutBounce, t)
+}
+
+// OutBounce bounces against the end like a dropped ball.
+func OutBounce(t float64) float64 {
+	const n, d = 7.5625, 2.75
+	switch {
+	case t < 1/d:
+		return n * t * t
+	case t < 2/d:
+		t -= 1.5 / d
+		return n*t*t + 0.75
+	case t < 2.5/d:
+		t -= 2.25 / d
+		return n*t*t + 0.9375
+	default:
+		t -= 2.625 / d
+		return n*t*t + 0.984375
+	}
+}
+
+func InOutBounce(t float64) float64 {
+	return inOut(InBounce, t)
+}
+
+// out mirrors an ease in to make the matching ease out.
+func out(in Easing, t float64) float64 {
+	return 1 - in(1-t)
+}
+
+// inOut eases in for the first half and out for the second.
+func inOut(in Easing, t float64) float64 {
+	if t < 0.5 {
+		return in(2*t) / 2
+	}
+	return 1 - in(2-2*t)/2
+}
@@ -0,0 +1,186 @@
+package tween
+
+import "math"
+
+// Sequence plays animations one after another.
+type Sequence struct {
+	Animations []Animation
+	OnComplete func()
+
+	current int
+}
+
+func NewSequence(animations ...Animation) *Sequence {
+	return &Sequence{Animations: animations}
+}
+
+func (s *Sequence) Update(dt float64) float64 {
+	if s.Done() {
+		return dt
+	}
+	for s.current < len(s.Animations) {
+		dt = s.Animations[s.current].Update(dt)
+		if !s.Animations[s.current].Done() {
+			return 0
+		}
+		s.current++
+	}
+	if s.OnComplete != nil {
+		s.OnComplete()
+	}
+	return dt
+}
+
+func (s *Sequence) Done() bool {
+	return s.current >= len(s.Animations)
+}
+
+func (s *Sequence) Reset() {
+	s.current = 0
+	for _, a := range s.Animations {
+		a.Reset()
+	}
+}
+
+// Parallel plays animations at the same time and finishes when they all have.
+type Parallel struct {
+	Animations []Animation
+	OnComplete func()
+
+	done bool
+}
+
+func NewParallel(animations ...Animation) *Parallel {
+	return &Parallel{Animations: animations}
+}
+
+func (p *Parallel) Update(dt float64) float64 {
+	if p.done {
+		return dt
+	}
+	leftover := dt
+	running := false
+	for _, a := range p.Animations {
+		if a.Done() {
+			continue
+		}
+		left := a.Update(dt)
+		if a.Done() {
+			leftover = math.Min(leftover, left)
+		} else {
+			running = true
+		}
+	}
+	if running {
+		return 0
+	}
+
+	p.done = true
+	if p.OnComplete != nil {
+		p.OnComplete()
+	}
+	return leftover
+}
+
+func (p *Parallel) Done() bool {
+	return p.done
+}
+
+func (p *Parallel) Reset() {
+	p.done = false
+	for _, a := range p.Animations {
+		a.Reset()
+	}
+}
+
+// Wait is an animation that does nothing for a while, to space out a sequence.
+type Wait struct {
+	Duration float64
+
+	elapsed float64
+}
+
+func NewWait(duration float64) *Wait {
+	return &Wait{Duration: duration}
+}
+
+func (w *Wait) Update(dt float64) float64 {
+	if w.Done() {
+		return dt
+	}
+	w.elapsed += dt
+	return math.Max(0, w.elapsed-w.Duration)
+}
+
+func (w *Wait) Done() bool {
+	return w.elapsed >= w.Duration
+}
+
+func (w *Wait) Reset() {
+	w.elapsed = 0
+}
+
+// Call is an animation that runs a function and finishes immediately, to trigger events in a sequence.
+type Call struct {
+	Func func()
+
+	done bool
+}
+
+func NewCall(f func()) *Call {
+	return &Call{Func: f}
+}
+
+func (c *Call) Update(dt float64) float64 {
+	if !c.done {
+		c.done = true
+		c.Func()
+	}
+	return dt
+}
+
+func (c *Call) Done() bool {
+	return c.done
+}
+
+func (c *Call) Reset() {
+	c.done = false
+}
+
+// Player updates a set of animations and drops each one when it finishes.
+type Player struct {
+	animations []Animation
+}
+
+func (p *Player) Add(a Animation) {
+	p.animations = append(p.animations, a)
+}
+
+// Update advances every animation in the order they were added.
+// Animations added by callbacks during the update start on the next one.
+func (p *Player) Update(dt float64) {
+	count := len(p.animations)
+	for i := 0; i < count; i++ {
+		p.animations[i].Update(dt)
+	}
+
+	n := 0
+	for _, a := range p.animations {
+		if !a.Done() {
+			p.animations[n] = a
+			n++
+		}
+	}
+	for i := n; i < len(p.animations); i++ {
+		p.animations[i] = nil
+	}
+	p.animations = p.animations[:n]
+}
+
+func (p *Player) Len() int {
+	return len(p.animations)
+}
+
+func (p *Player) Clear() {
+	p.animations = p.animations[:0]
+}
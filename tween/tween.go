@@ -0,0 +1,141 @@
+// Package tween animates values over time with easing.
+// Nothing reads the clock; everything advances only when Update is called with a time step.
+package tween
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/maths/color"
+	imagecolor "image/color"
+	"math"
+)
+
+// Animation is anything that plays over time.
+type Animation interface {
+	// Update advances the animation by dt and returns the part of dt left over after it finished,
+	// so a following animation can start at exactly the right moment.
+	Update(dt float64) float64
+	Done() bool
+	// Reset rewinds the animation to the start.
+	Reset()
+}
+
+// Tween animates a value from From to To over Duration.
+type Tween[T any] struct {
+	From, To T
+	Duration float64
+	// Delay is how long to wait before starting.
+	Delay float64
+	Ease  Easing
+	// Repeat is how many more times to play after the first, or -1 to repeat forever.
+	Repeat int
+	// Yoyo makes every other repeat play backwards.
+	Yoyo bool
+
+	OnUpdate   func(value T)
+	OnComplete func()
+
+	lerp    func(a, b T, t float64) T
+	value   T
+	elapsed float64
+	done    bool
+}
+
+// New creates a linear tween that uses lerp to interpolate between values.
+func New[T any](from, to T, duration float64, lerp func(a, b T, t float64) T) *Tween[T] {
+	return &Tween[T]{
+		From:     from,
+		To:       to,
+		Duration: duration,
+		Ease:     Linear,
+		lerp:     lerp,
+		value:    from,
+	}
+}
+
+func NewFloat(from, to, duration float64) *Tween[float64] {
+	return New(from, to, duration, func(a, b, t float64) float64 {
+		return a + (b-a)*t
+	})
+}
+
+func NewVector2(from, to maths.Vector2, duration float64) *Tween[maths.Vector2] {
+	return New(from, to, duration, maths.Vector2.Lerp)
+}
+
+func NewVector3(from, to maths.Vector3, duration float64) *Tween[maths.Vector3] {
+	return New(from, to, duration, maths.Vector3.Lerp)
+}
+
+// NewColor tweens between colors, mixing them in space. Easings that overshoot carry the
+// components past the end colors.
+func NewColor(from, to color.Color, duration float64, space color.Interpolation) *Tween[color.Color] {
+	return New(from, to, duration, func(a, b color.Color, t float64) color.Color {
+		return color.Mix(a, b, t, space)
+	})
+}
+
+// NewRGBA tweens 8 bit colors the same way as NewColor. Easings that overshoot are clamped to the
+// channel range.
+func NewRGBA(from, to imagecolor.RGBA, duration float64, space color.Interpolation) *Tween[imagecolor.RGBA] {
+	return New(from, to, duration, func(a, b imagecolor.RGBA, t float64) imagecolor.RGBA {
+		return color.Mix(color.FromColor(a), color.FromColor(b), t, space).RGBA8()
+	})
+}
+
+// Value returns the value at the current time.
+func (tw *Tween[T]) Value() T {
+	return tw.value
+}
+
+func (tw *Tween[T]) Done() bool {
+	return tw.done
+}
+
+func (tw *Tween[T]) Reset() {
+	tw.elapsed = 0
+	tw.done = false
+	tw.value = tw.From
+}
+
+func (tw *Tween[T]) Update(dt float64) float64 {
+	if tw.done {
+		return dt
+	}
+	tw.elapsed += dt
+	active := tw.elapsed - tw.Delay
+	if active < 0 {
+		return 0
+	}
+
+	plays := tw.Repeat + 1
+	if tw.Repeat >= 0 && (tw.Duration <= 0 || active >= tw.Duration*float64(plays)) {
+		tw.done = true
+		tw.value = tw.To
+		if tw.Yoyo && plays%2 == 0 {
+			tw.value = tw.From
+		}
+		if tw.OnUpdate != nil {
+			tw.OnUpdate(tw.value)
+		}
+		if tw.OnComplete != nil {
+			tw.OnComplete()
+		}
+		return math.Max(0, active-math.Max(0, tw.Duration)*float64(plays))
+	}
+	if tw.Duration <= 0 {
+		// repeating forever with no duration has nothing to show but the end
+		tw.value = tw.To
+		return 0
+	}
+
+	play := math.Floor(active / tw.Duration)
+	progress := active/tw.Duration - play
+	if tw.Yoyo && int64(play)%2 == 1 {
+		progress = 1 - progress
+	}
+	tw.value = tw.lerp(tw.From, tw.To, tw.Ease(progress))
+	if tw.OnUpdate != nil {
+		tw.OnUpdate(tw.value)
+	}
+	return 0
+}
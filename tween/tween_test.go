@@ -0,0 +1,195 @@
+package tween_test
+
+import (
+	"github.com/soupstoregames/gamelib/maths"
+	"github.com/soupstoregames/gamelib/maths/color"
+	"github.com/soupstoregames/gamelib/tween"
+	"github.com/stretchr/testify/assert"
+	imagecolor "image/color"
+	"math"
+	"testing"
+)
+
+func TestEasings(t *testing.T) {
+	easings := map[string]tween.Easing{
+		"Linear": tween.Linear,
+		"InQuad": tween.InQuad, "OutQuad": tween.OutQuad, "InOutQuad": tween.InOutQuad,
+		"InCubic": tween.InCubic, "OutCubic": tween.OutCubic, "InOutCubic": tween.InOutCubic,
+		"InQuart": tween.InQuart, "OutQuart": tween.OutQuart, "InOutQuart": tween.InOutQuart,
+		"InQuint": tween.InQuint, "OutQuint": tween.OutQuint, "InOutQuint": tween.InOutQuint,
+		"InSine": tween.InSine, "OutSine": tween.OutSine, "InOutSine": tween.InOutSine,
+		"InExpo": tween.InExpo, "OutExpo": tween.OutExpo, "InOutExpo": tween.InOutExpo,
+		"InCirc": tween.InCirc, "OutCirc": tween.OutCirc, "InOutCirc": tween.InOutCirc,
+		"InBack": tween.InBack, "OutBack": tween.OutBack, "InOutBack": tween.InOutBack,
+		"InElastic": tween.InElastic, "OutElastic": tween.OutElastic, "InOutElastic": tween.InOutElastic,
+		"InBounce": tween.InBounce, "OutBounce": tween.OutBounce, "InOutBounce": tween.InOutBounce,
+	}
+
+	for name, ease := range easings {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, 0, ease(0), 1e-3)
+			assert.InDelta(t, 1, ease(1), 1e-3)
+			if name[:5] == "InOut" {
+				assert.InDelta(t, 0.5, ease(0.5), 1e-3, "symmetric")
+			}
+		})
+	}
+
+	assert.Less(t, tween.InQuad(0.25), 0.25)
+	assert.Greater(t, tween.OutQuad(0.25), 0.25)
+	assert.Less(t, tween.InBack(0.2), 0.0, "pulls back")
+	assert.Greater(t, tween.OutBack(0.8), 1.0, "overshoots")
+}
+
+func TestTween(t *testing.T) {
+	var updates []float64
+	completed := 0
+	tw := tween.NewFloat(10, 20, 2)
+	tw.OnUpdate = func(v float64) { updates = append(updates, v) }
+	tw.OnComplete = func() { completed++ }
+
+	assert.Equal(t, 0.0, tw.Update(0.5))
+	assert.Equal(t, 12.5, tw.Value())
+	tw.Update(1)
+	assert.Equal(t, 17.5, tw.Value())
+	assert.False(t, tw.Done())
+
+	assert.Equal(t, 0.25, tw.Update(0.75), "leftover time")
+	assert.Equal(t, 20.0, tw.Value())
+	assert.True(t, tw.Done())
+	assert.Equal(t, []float64{12.5, 17.5, 20}, updates)
+
+	tw.Update(1)
+	assert.Equal(t, 1, completed)
+
+	tw.Reset()
+	assert.Equal(t, 10.0, tw.Value())
+	assert.False(t, tw.Done())
+}
+
+func TestTween_DelayAndEase(t *testing.T) {
+	tw := tween.NewFloat(0, 1, 1)
+	tw.Delay = 0.5
+	tw.Ease = tween.InQuad
+
+	tw.Update(0.25)
+	assert.Equal(t, 0.0, tw.Value())
+	tw.Update(0.75)
+	assert.Equal(t, 0.25, tw.Value())
+}
+
+func TestTween_RepeatYoyo(t *testing.T) {
+	tw := tween.NewFloat(0, 10, 1)
+	tw.Repeat = 2
+	tw.Yoyo = true
+
+	var values []float64
+	for i := 0; i < 6; i++ {
+		tw.Update(0.5)
+		values = append(values, tw.Value())
+	}
+	assert.Equal(t, []float64{5, 10, 5, 0, 5, 10}, values)
+	assert.True(t, tw.Done())
+
+	tw.Reset()
+	tw.Update(1.25)
+	assert.Equal(t, 7.5, tw.Value(), "playing backwards")
+
+	forever := tween.NewFloat(0, 10, 1)
+	forever.Repeat = -1
+	forever.Update(1000.5)
+	assert.False(t, forever.Done())
+	assert.Equal(t, 5.0, forever.Value())
+}
+
+func TestTween_Types(t *testing.T) {
+	v2 := tween.NewVector2(maths.Vector2{}, maths.Vector2{X: 4, Y: 2}, 1)
+	v2.Update(0.5)
+	assert.Equal(t, maths.Vector2{X: 2, Y: 1}, v2.Value())
+
+	v3 := tween.NewVector3(maths.Vector3{}, maths.Vector3{Z: 4}, 1)
+	v3.Update(0.25)
+	assert.Equal(t, maths.Vector3{Z: 1}, v3.Value())
+
+	c := tween.NewRGBA(imagecolor.RGBA{A: 255}, imagecolor.RGBA{R: 255, G: 100, A: 255}, 1, color.InterpolateSRGB)
+	c.Update(0.5)
+	assert.Equal(t, imagecolor.RGBA{R: 128, G: 50, A: 255}, c.Value())
+
+	c.Reset()
+	c.Ease = tween.OutBack
+	c.Update(0.8)
+	assert.Equal(t, uint8(255), c.Value().R, "overshoot is clamped")
+
+	// mixing in linear light keeps the midpoint brighter than the sRGB average
+	mix := tween.NewColor(color.Black, color.White, 1, color.InterpolateLinear)
+	mix.Update(0.5)
+	assert.InDelta(t, color.LinearToSRGB(0.5), mix.Value().R, 1e-9)
+	assert.Equal(t, 1.0, mix.Value().A)
+}
+
+func TestSequence(t *testing.T) {
+	var events []string
+	a := tween.NewFloat(0, 1, 1)
+	b := tween.NewFloat(0, 1, 1)
+	seq := tween.NewSequence(
+		a,
+		tween.NewCall(func() { events = append(events, "halfway") }),
+		tween.NewWait(0.5),
+		b,
+	)
+	seq.OnComplete = func() { events = append(events, "done") }
+
+	seq.Update(1.25)
+	assert.True(t, a.Done())
+	assert.Equal(t, []string{"halfway"}, events)
+	assert.Equal(t, 0.0, b.Value())
+
+	seq.Update(0.5)
+	assert.Equal(t, 0.25, b.Value(), "leftover time carries into the next animation")
+
+	assert.Equal(t, 0.25, seq.Update(1))
+	assert.True(t, seq.Done())
+	assert.Equal(t, []string{"halfway", "done"}, events)
+
+	seq.Reset()
+	assert.False(t, seq.Done())
+	assert.Equal(t, 0.0, a.Value())
+}
+
+func TestParallel(t *testing.T) {
+	short := tween.NewFloat(0, 1, 1)
+	long := tween.NewFloat(0, 1, 2)
+	completed := false
+	p := tween.NewParallel(short, long)
+	p.OnComplete = func() { completed = true }
+
+	p.Update(1.5)
+	assert.True(t, short.Done())
+	assert.Equal(t, 0.75, long.Value())
+	assert.False(t, p.Done())
+
+	assert.Equal(t, 0.5, p.Update(1))
+	assert.True(t, completed)
+}
+
+func TestPlayer(t *testing.T) {
+	var player tween.Player
+	var chained *tween.Tween[float64]
+	first := tween.NewFloat(0, 1, 1)
+	first.OnComplete = func() {
+		chained = tween.NewFloat(0, 1, 1)
+		player.Add(chained)
+	}
+	player.Add(first)
+	player.Add(tween.NewFloat(0, 1, 3))
+
+	player.Update(1)
+	assert.Equal(t, 2, player.Len())
+	assert.Equal(t, 0.0, chained.Value(), "added during update starts next update")
+
+	player.Update(1)
+	assert.Equal(t, 1, player.Len())
+	player.Update(1)
+	assert.Equal(t, 0, player.Len())
+	assert.False(t, math.IsNaN(chained.Value()))
+}